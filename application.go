@@ -0,0 +1,224 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+)
+
+const MAX_ROLE_CONNECTION_METADATA = 5
+
+// https://discord.com/developers/docs/resources/application#application-object-application-flags
+type ApplicationFlags BitSet
+
+const (
+	AUTO_MODERATION_RULE_CREATE_BADGE_APPLICATION_FLAG ApplicationFlags = 1 << 6  // App uses auto moderation API.
+	GATEWAY_PRESENCE_APPLICATION_FLAG                  ApplicationFlags = 1 << 12 // Presence intent, for apps in 100+ guilds.
+	GATEWAY_PRESENCE_LIMITED_APPLICATION_FLAG          ApplicationFlags = 1 << 13 // Presence intent, for apps in under 100 guilds.
+	GATEWAY_GUILD_MEMBERS_APPLICATION_FLAG             ApplicationFlags = 1 << 14 // Guild members intent, for apps in 100+ guilds.
+	GATEWAY_GUILD_MEMBERS_LIMITED_APPLICATION_FLAG     ApplicationFlags = 1 << 15 // Guild members intent, for apps in under 100 guilds.
+	VERIFICATION_PENDING_GUILD_LIMIT_APPLICATION_FLAG  ApplicationFlags = 1 << 16 // Unusual growth of app prevented verification.
+	EMBEDDED_APPLICATION_FLAG                          ApplicationFlags = 1 << 17 // App is embedded within Discord client (activity).
+	GATEWAY_MESSAGE_CONTENT_APPLICATION_FLAG           ApplicationFlags = 1 << 18 // Message content intent, for apps in 100+ guilds.
+	GATEWAY_MESSAGE_CONTENT_LIMITED_APPLICATION_FLAG   ApplicationFlags = 1 << 19 // Message content intent, for apps in under 100 guilds.
+	APPLICATION_COMMAND_BADGE_APPLICATION_FLAG         ApplicationFlags = 1 << 23 // App has at least one global command.
+)
+
+// Has will ensure that the set includes all the flags entered.
+func (f ApplicationFlags) Has(flags ...ApplicationFlags) bool {
+	for _, flag := range flags {
+		if (f & flag) != flag {
+			return false
+		}
+	}
+	return true
+}
+
+// Whether app can request member lists (like Client.StreamMembers) - either full or limited guild members intent is enabled.
+func (f ApplicationFlags) HasMembersIntent() bool {
+	return f&(GATEWAY_GUILD_MEMBERS_APPLICATION_FLAG|GATEWAY_GUILD_MEMBERS_LIMITED_APPLICATION_FLAG) != 0
+}
+
+// https://discord.com/developers/docs/resources/application#application-object
+type Application struct {
+	ID                             Snowflake                                                   `json:"id"`
+	Name                           string                                                      `json:"name"`
+	IconHash                       string                                                      `json:"icon,omitempty"`
+	Description                    string                                                      `json:"description"`
+	RPCOrigins                     []string                                                    `json:"rpc_origins,omitzero"`
+	BotPublic                      bool                                                        `json:"bot_public"`             // Whether anyone (not only app owner) can add bot to guilds.
+	BotRequireCodeGrant            bool                                                        `json:"bot_require_code_grant"` // Whether bot requires completion of full OAuth2 code grant flow to join.
+	Bot                            *User                                                       `json:"bot,omitempty"`
+	TermsOfServiceURL              string                                                      `json:"terms_of_service_url,omitempty"`
+	PrivacyPolicyURL               string                                                      `json:"privacy_policy_url,omitempty"`
+	Owner                          *User                                                       `json:"owner,omitempty"`
+	VerifyKey                      string                                                      `json:"verify_key"` // Hex encoded public key used to verify interactions, the same as ClientOptions.PublicKey.
+	Team                           *Team                                                       `json:"team,omitempty"`
+	GuildID                        Snowflake                                                   `json:"guild_id,omitempty"`
+	PrimarySkuID                   Snowflake                                                   `json:"primary_sku_id,omitempty"`
+	Slug                           string                                                      `json:"slug,omitempty"`
+	CoverImageHash                 string                                                      `json:"cover_image,omitempty"`
+	Flags                          ApplicationFlags                                            `json:"flags,omitempty"`
+	ApproximateGuildCount          uint32                                                      `json:"approximate_guild_count,omitempty"`
+	ApproximateUserInstallCount    uint32                                                      `json:"approximate_user_install_count,omitempty"`
+	RedirectURIs                   []string                                                    `json:"redirect_uris,omitzero"`
+	InteractionsEndpointURL        string                                                      `json:"interactions_endpoint_url,omitempty"`
+	RoleConnectionsVerificationURL string                                                      `json:"role_connections_verification_url,omitempty"`
+	EventWebhooksURL               string                                                      `json:"event_webhooks_url,omitempty"`
+	EventWebhooksTypes             []EventType                                                 `json:"event_webhooks_types,omitzero"`
+	Tags                           []string                                                    `json:"tags,omitzero"`
+	InstallParams                  *InstallParams                                              `json:"install_params,omitempty"`
+	IntegrationTypesConfig         map[ApplicationIntegrationType]ApplicationIntegrationConfig `json:"integration_types_config,omitzero"`
+	CustomInstallURL               string                                                      `json:"custom_install_url,omitempty"`
+}
+
+// https://discord.com/developers/docs/resources/application#install-params-object
+type InstallParams struct {
+	Scopes      []string        `json:"scopes"`
+	Permissions PermissionFlags `json:"permissions,string"`
+}
+
+// https://discord.com/developers/docs/resources/application#application-object-application-integration-type-configuration-object
+type ApplicationIntegrationConfig struct {
+	OAuth2InstallParams *InstallParams `json:"oauth2_install_params,omitempty"`
+}
+
+// https://discord.com/developers/docs/topics/teams#data-models-team-object
+type Team struct {
+	ID          Snowflake    `json:"id"`
+	Name        string       `json:"name"`
+	IconHash    string       `json:"icon,omitempty"`
+	Members     []TeamMember `json:"members"`
+	OwnerUserID Snowflake    `json:"owner_user_id"`
+}
+
+// https://discord.com/developers/docs/topics/teams#data-models-team-member-object
+type TeamMember struct {
+	MembershipState uint8     `json:"membership_state"` // 1 = invited, 2 = accepted.
+	TeamID          Snowflake `json:"team_id"`
+	User            User      `json:"user"`
+	Role            string    `json:"role"` // One of "admin", "developer" or "read_only". Owner is described by Team.OwnerUserID.
+}
+
+// Fields of application that can be edited by bot. Empty fields are left unchanged.
+//
+// https://discord.com/developers/docs/resources/application#edit-current-application-json-params
+type EditApplicationPayload struct {
+	CustomInstallURL               string                                                      `json:"custom_install_url,omitempty"`
+	Description                    string                                                      `json:"description,omitempty"`
+	RoleConnectionsVerificationURL string                                                      `json:"role_connections_verification_url,omitempty"`
+	InstallParams                  *InstallParams                                              `json:"install_params,omitempty"`
+	IntegrationTypesConfig         map[ApplicationIntegrationType]ApplicationIntegrationConfig `json:"integration_types_config,omitzero"`
+	Flags                          ApplicationFlags                                            `json:"flags,omitempty"` // Only limited intent flags can be updated.
+	Icon                           string                                                      `json:"icon,omitempty"`  // Data URI scheme image, like "data:image/png;base64,...".
+	CoverImage                     string                                                      `json:"cover_image,omitempty"`
+	InteractionsEndpointURL        string                                                      `json:"interactions_endpoint_url,omitempty"`
+	Tags                           []string                                                    `json:"tags,omitzero"` // Up to 5 tags (max 20 characters each) describing app.
+	EventWebhooksURL               string                                                      `json:"event_webhooks_url,omitempty"`
+	EventWebhooksStatus            uint8                                                       `json:"event_webhooks_status,omitempty"` // 1 = disabled, 2 = enabled.
+	EventWebhooksTypes             []EventType                                                 `json:"event_webhooks_types,omitzero"`
+}
+
+// https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object-application-role-connection-metadata-type
+type RoleConnectionMetadataType uint8
+
+const (
+	INTEGER_LESS_THAN_OR_EQUAL_METADATA_TYPE     RoleConnectionMetadataType = iota + 1 // Metadata value is less than or equal to guild's configured value.
+	INTEGER_GREATER_THAN_OR_EQUAL_METADATA_TYPE                                        // Metadata value is greater than or equal to guild's configured value.
+	INTEGER_EQUAL_METADATA_TYPE                                                        // Metadata value is equal to guild's configured value.
+	INTEGER_NOT_EQUAL_METADATA_TYPE                                                    // Metadata value is not equal to guild's configured value.
+	DATETIME_LESS_THAN_OR_EQUAL_METADATA_TYPE                                          // Metadata value (ISO8601 string) is less than or equal to guild's configured value (days before current date).
+	DATETIME_GREATER_THAN_OR_EQUAL_METADATA_TYPE                                       // Metadata value (ISO8601 string) is greater than or equal to guild's configured value (days before current date).
+	BOOLEAN_EQUAL_METADATA_TYPE                                                        // Metadata value is equal to guild's configured value (1).
+	BOOLEAN_NOT_EQUAL_METADATA_TYPE                                                    // Metadata value is not equal to guild's configured value (1).
+)
+
+// Requirement that guilds can use in linked roles, see Client.UpdateRoleConnectionMetadata.
+//
+// https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object
+type RoleConnectionMetadata struct {
+	Type                     RoleConnectionMetadataType `json:"type"`
+	Key                      string                     `json:"key"` // Dictionary key for metadata field (a-z, 0-9 or _ characters, max 50 characters).
+	Name                     string                     `json:"name"`
+	NameLocalizations        map[Language]string        `json:"name_localizations,omitzero"`
+	Description              string                     `json:"description"`
+	DescriptionLocalizations map[Language]string        `json:"description_localizations,omitzero"`
+}
+
+// Returns application object associated with bot token.
+//
+// https://discord.com/developers/docs/resources/application#get-current-application
+func (client *Client) FetchApplication() (Application, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/applications/@me", nil)
+	if err != nil {
+		return Application{}, err
+	}
+
+	res := Application{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Application{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Edits properties of application associated with bot token and returns updated application.
+// Setting InteractionsEndpointURL makes Discord send ping to it first, so app has to already listen under that url.
+//
+// https://discord.com/developers/docs/resources/application#edit-current-application
+func (client *Client) EditApplication(payload EditApplicationPayload) (Application, error) {
+	raw, err := client.Rest.Request(http.MethodPatch, "/applications/@me", payload)
+	if err != nil {
+		return Application{}, err
+	}
+
+	res := Application{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Application{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// https://discord.com/developers/docs/resources/application-role-connection-metadata#get-application-role-connection-metadata-records
+func (client *Client) FetchRoleConnectionMetadata() ([]RoleConnectionMetadata, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/applications/"+client.ApplicationID.String()+"/role-connections/metadata", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]RoleConnectionMetadata, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Replaces all role connection metadata records of application (max 5). Send empty slice to remove all of them.
+//
+// https://discord.com/developers/docs/resources/application-role-connection-metadata#update-application-role-connection-metadata-records
+func (client *Client) UpdateRoleConnectionMetadata(records []RoleConnectionMetadata) ([]RoleConnectionMetadata, error) {
+	if len(records) > MAX_ROLE_CONNECTION_METADATA {
+		return nil, errors.New("application can have at most 5 role connection metadata records")
+	}
+
+	if records == nil {
+		records = make([]RoleConnectionMetadata, 0)
+	}
+
+	raw, err := client.Rest.Request(http.MethodPut, "/applications/"+client.ApplicationID.String()+"/role-connections/metadata", records)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]RoleConnectionMetadata, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,218 @@
+package tempest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const DEFAULT_MAX_DOWNLOAD_SIZE = 25 << 20 // 25 MB, default upload limit for regular users.
+
+// Returned by Client.DownloadAttachment when attachment is larger than ClientOptions.MaxDownloadSize.
+var ErrAttachmentTooLarge = errors.New("attachment exceeds max download size")
+
+// Describes downloaded attachment, see Client.DownloadAttachment.
+type AttachmentDownload struct {
+	Size   int64  // Number of bytes written.
+	SHA256 string // Hex encoded SHA-256 checksum of downloaded content, useful for deduplication or integrity checks.
+}
+
+type downloadWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (writer *downloadWriter) Write(p []byte) (int, error) {
+	n, err := writer.w.Write(p)
+	writer.n += int64(n)
+	writer.err = err
+	return n, err
+}
+
+// Mirror method to Client.DownloadAttachmentWithContext but with background context.
+func (client *Client) DownloadAttachment(attachment Attachment, w io.Writer) (AttachmentDownload, error) {
+	return client.DownloadAttachmentWithContext(context.Background(), attachment, w)
+}
+
+// Streams attachment content from Discord's CDN into w. Expired signed urls (check "ex" query param) are refreshed first.
+// Transient failures are retried (up to Rest.MaxRetries times), resuming from already written byte when CDN supports it.
+// Attachments larger than ClientOptions.MaxDownloadSize are rejected with ErrAttachmentTooLarge, before or while downloading.
+func (client *Client) DownloadAttachmentWithContext(ctx context.Context, attachment Attachment, w io.Writer) (AttachmentDownload, error) {
+	if attachment.URL == "" {
+		return AttachmentDownload{}, errors.New("attachment has no url")
+	}
+
+	if client.maxDownloadSize > 0 && int64(attachment.Size) > client.maxDownloadSize {
+		return AttachmentDownload{}, ErrAttachmentTooLarge
+	}
+
+	link := attachment.URL
+	refreshed := false
+	if attachmentURLExpired(link) {
+		fresh, err := client.refreshAttachmentURL(link)
+		if err != nil {
+			return AttachmentDownload{}, err
+		}
+		link, refreshed = fresh, true
+	}
+
+	hash := sha256.New()
+	writer := &downloadWriter{w: io.MultiWriter(w, hash)}
+
+	var lastErr error
+	for attempt := 0; attempt <= int(client.Rest.MaxRetries); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Second * time.Duration(attempt)):
+			case <-ctx.Done():
+				return AttachmentDownload{Size: writer.n}, ctx.Err()
+			}
+		}
+
+		status, err := client.downloadAttempt(ctx, link, writer)
+		if err == nil {
+			return AttachmentDownload{Size: writer.n, SHA256: hex.EncodeToString(hash.Sum(nil))}, nil
+		}
+
+		lastErr = err
+		if writer.err != nil || ctx.Err() != nil || errors.Is(err, ErrAttachmentTooLarge) {
+			break
+		}
+
+		// Signed url was rejected - it may have expired during retries or its signature is outdated.
+		if (status == http.StatusForbidden || status == http.StatusNotFound) && !refreshed {
+			fresh, err := client.refreshAttachmentURL(link)
+			if err != nil {
+				return AttachmentDownload{Size: writer.n}, err
+			}
+			link, refreshed = fresh, true
+			continue
+		}
+
+		if status != 0 && status != http.StatusTooManyRequests && status < 500 {
+			break
+		}
+
+		client.logger.Debug("retrying attachment download", "attachment", attachment.ID, "attempt", attempt+1, "written", writer.n, "error", err)
+	}
+
+	return AttachmentDownload{Size: writer.n}, lastErr
+}
+
+// Exchanges expired attachment urls for freshly signed ones. Returned map is keyed by original url.
+//
+// https://discord.com/developers/docs/reference#signed-attachment-cdn-urls
+func (client *Client) RefreshAttachmentURLs(urls []string) (map[string]string, error) {
+	raw, err := client.Rest.Request(http.MethodPost, "/attachments/refresh-urls", map[string]any{
+		"attachment_urls": urls,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		RefreshedURLs []struct {
+			Original  string `json:"original"`
+			Refreshed string `json:"refreshed"`
+		} `json:"refreshed_urls"`
+	}
+	if err := unmarshalJSON(raw, &res); err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	refreshed := make(map[string]string, len(res.RefreshedURLs))
+	for _, entry := range res.RefreshedURLs {
+		refreshed[entry.Original] = entry.Refreshed
+	}
+
+	return refreshed, nil
+}
+
+func (client *Client) refreshAttachmentURL(link string) (string, error) {
+	refreshed, err := client.RefreshAttachmentURLs([]string{link})
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh attachment url: %w", err)
+	}
+
+	if fresh := refreshed[link]; fresh != "" {
+		return fresh, nil
+	}
+	return "", errors.New("discord didn't refresh attachment url")
+}
+
+// Downloads (rest of) attachment into writer. Returns status code of rejected response, or 0 if failure happened elsewhere.
+func (client *Client) downloadAttempt(ctx context.Context, link string, writer *downloadWriter) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to initialize new request: %w", err)
+	}
+
+	offset := writer.n
+	req.Header.Set("User-Agent", USER_AGENT)
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	res, err := client.Rest.HTTPClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to process request: %w", err)
+	}
+	defer res.Body.Close()
+
+	var body io.Reader = res.Body
+	switch {
+	case res.StatusCode == http.StatusPartialContent && offset > 0:
+	case res.StatusCode == http.StatusOK:
+		// CDN ignored range request - skip part that was already written.
+		if _, err := io.CopyN(io.Discard, res.Body, offset); err != nil {
+			return 0, fmt.Errorf("failed to read attachment: %w", err)
+		}
+	default:
+		return res.StatusCode, fmt.Errorf("failed to download attachment: %s", res.Status)
+	}
+
+	if client.maxDownloadSize > 0 {
+		total := res.ContentLength
+		if res.StatusCode == http.StatusPartialContent {
+			total += offset
+		}
+
+		if res.ContentLength >= 0 && total > client.maxDownloadSize {
+			return 0, ErrAttachmentTooLarge
+		}
+		body = io.LimitReader(body, client.maxDownloadSize-offset+1)
+	}
+
+	if _, err := io.Copy(writer, body); err != nil {
+		return 0, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	if client.maxDownloadSize > 0 && writer.n > client.maxDownloadSize {
+		return 0, ErrAttachmentTooLarge
+	}
+
+	return 0, nil
+}
+
+// Whether signed CDN url is past its expiry time (hex encoded unix timestamp in "ex" query param).
+func attachmentURLExpired(link string) bool {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(parsed.Query().Get("ex"), 16, 64)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Unix() >= expiresAt
+}
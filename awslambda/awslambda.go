@@ -0,0 +1,121 @@
+// Package awslambda runs tempest handlers on AWS Lambda behind Function URL or API Gateway HTTP API (payload format 2.0),
+// without depending on AWS SDK. Pass returned function to lambda.Start from github.com/aws/aws-lambda-go:
+//
+//	client := tempest.NewClient(tempest.ClientOptions{...})
+//	lambda.Start(awslambda.Handler(&client))
+//
+// Any http.Handler works, so webhook events can be served with awslambda.Handler(http.HandlerFunc(client.EventHandler)).
+// Lambda freezes once response is returned, so respond to interactions before doing any long work in background.
+package awslambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+// https://docs.aws.amazon.com/lambda/latest/dg/urls-invocation.html#urls-payloads
+type Request struct {
+	RawPath         string            `json:"rawPath"`
+	RawQueryString  string            `json:"rawQueryString"`
+	Headers         map[string]string `json:"headers"`
+	Body            string            `json:"body"`
+	IsBase64Encoded bool              `json:"isBase64Encoded"`
+	RequestContext  RequestContext    `json:"requestContext"`
+}
+
+type RequestContext struct {
+	HTTP RequestContextHTTP `json:"http"`
+}
+
+type RequestContextHTTP struct {
+	Method   string `json:"method"`
+	SourceIP string `json:"sourceIp"`
+}
+
+// https://docs.aws.amazon.com/lambda/latest/dg/urls-invocation.html#urls-response-payload
+type Response struct {
+	StatusCode      int               `json:"statusCode"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Body            string            `json:"body"`
+	IsBase64Encoded bool              `json:"isBase64Encoded"`
+}
+
+// Returns Lambda handler function that translates each invocation into HTTP request for given handler.
+func Handler(handler http.Handler) func(ctx context.Context, req Request) (Response, error) {
+	return func(ctx context.Context, req Request) (Response, error) {
+		r, err := NewHTTPRequest(ctx, req)
+		if err != nil {
+			return Response{StatusCode: http.StatusBadRequest, Body: "bad request - " + err.Error()}, nil
+		}
+
+		w := tempest.NewResponseBuffer()
+		handler.ServeHTTP(w, r)
+		return newResponse(w.Response()), nil
+	}
+}
+
+// Converts Lambda invocation payload into regular HTTP request. Source IP is kept as request's RemoteAddr.
+func NewHTTPRequest(ctx context.Context, req Request) (*http.Request, error) {
+	body := []byte(req.Body)
+	if req.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = decoded
+	}
+
+	method := req.RequestContext.HTTP.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	path := req.RawPath
+	if path == "" {
+		path = "/"
+	}
+
+	if req.RawQueryString != "" {
+		path += "?" + req.RawQueryString
+	}
+
+	r, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range req.Headers {
+		r.Header.Set(name, value)
+	}
+
+	r.RemoteAddr = req.RequestContext.HTTP.SourceIP
+	return r, nil
+}
+
+// Converts response collected from handler into Lambda response payload.
+func newResponse(res tempest.InteractionHTTPResponse) Response {
+	lambdaRes := Response{
+		StatusCode: res.StatusCode,
+		Headers:    make(map[string]string, len(res.Header)),
+	}
+
+	for name, values := range res.Header {
+		lambdaRes.Headers[name] = strings.Join(values, ", ")
+	}
+
+	// Multipart responses (with attached files) may carry binary data, which Lambda only accepts as base64.
+	if utf8.Valid(res.Body) {
+		lambdaRes.Body = string(res.Body)
+	} else {
+		lambdaRes.Body = base64.StdEncoding.EncodeToString(res.Body)
+		lambdaRes.IsBase64Encoded = true
+	}
+
+	return lambdaRes
+}
@@ -0,0 +1,277 @@
+package tempest
+
+import (
+	"time"
+)
+
+// CacheStore is a storage backend used by Cache. Values are already JSON encoded so any key-value database can be used.
+// Implementation has to be safe for concurrent use. Check MemoryCacheStore for default one.
+type CacheStore interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration) // Use ttl = 0 to keep value until it's deleted.
+	Delete(key string)
+}
+
+// Optional extension of CacheStore for backends that can atomically claim a key.
+// Features that coordinate multiple replicas (like Cooldown) use it when available and fall back to Get + Set otherwise.
+type AtomicCacheStore interface {
+	CacheStore
+	SetIfAbsent(key string, value []byte, ttl time.Duration) bool // Returns false if key already exists (and is not expired).
+}
+
+// Optional extension of AtomicCacheStore for backends that can fail (like RedisStore), so callers can tell
+// store error apart from already existing key and fail open.
+type CheckedAtomicCacheStore interface {
+	AtomicCacheStore
+	TrySetIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) // Returns false with nil error if key already exists.
+}
+
+// Optional, in-memory cache for Discord entities. It's populated from REST responses & received interactions.
+// Tempest is not caching anything by default - enable it only if you often fetch the same data.
+type Cache struct {
+	store CacheStore
+	ttl   time.Duration
+	flags CacheOptions
+}
+
+type CacheOptions struct {
+	Store    CacheStore    // Storage backend, defaults to MemoryCacheStore.
+	TTL      time.Duration // How long each entry stays in cache. Use 0 to keep entries forever.
+	Users    bool          // Whether to cache users.
+	Members  bool          // Whether to cache guild members.
+	Roles    bool          // Whether to cache guild roles.
+	Channels bool          // Whether to cache channels.
+	Guilds   bool          // Whether to cache guilds.
+}
+
+func NewCache(opt CacheOptions) *Cache {
+	store := opt.Store
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+
+	return &Cache{
+		store: store,
+		ttl:   opt.TTL,
+		flags: opt,
+	}
+}
+
+func (cache *Cache) User(id Snowflake) (User, bool) {
+	if !cache.flags.Users {
+		return User{}, false
+	}
+	return cacheGet[User](cache, "user:"+id.String())
+}
+
+func (cache *Cache) SetUser(user User) {
+	if cache.flags.Users {
+		cacheSet(cache, "user:"+user.ID.String(), user)
+	}
+}
+
+func (cache *Cache) DeleteUser(id Snowflake) {
+	cache.store.Delete("user:" + id.String())
+}
+
+func (cache *Cache) Member(guildID Snowflake, userID Snowflake) (Member, bool) {
+	if !cache.flags.Members {
+		return Member{}, false
+	}
+
+	member, ok := cacheGet[Member](cache, "member:"+guildID.String()+":"+userID.String())
+	member.GuildID = guildID
+	return member, ok
+}
+
+// Caches member. Provided member has to include User field.
+func (cache *Cache) SetMember(guildID Snowflake, member Member) {
+	if cache.flags.Members && member.User != nil {
+		cacheSet(cache, "member:"+guildID.String()+":"+member.User.ID.String(), member)
+	}
+}
+
+func (cache *Cache) DeleteMember(guildID Snowflake, userID Snowflake) {
+	cache.store.Delete("member:" + guildID.String() + ":" + userID.String())
+}
+
+func (cache *Cache) Role(id Snowflake) (Role, bool) {
+	if !cache.flags.Roles {
+		return Role{}, false
+	}
+	return cacheGet[Role](cache, "role:"+id.String())
+}
+
+func (cache *Cache) SetRole(role Role) {
+	if cache.flags.Roles {
+		cacheSet(cache, "role:"+role.ID.String(), role)
+	}
+}
+
+func (cache *Cache) DeleteRole(id Snowflake) {
+	cache.store.Delete("role:" + id.String())
+}
+
+func (cache *Cache) Channel(id Snowflake) (Channel, bool) {
+	if !cache.flags.Channels {
+		return Channel{}, false
+	}
+	return cacheGet[Channel](cache, "channel:"+id.String())
+}
+
+func (cache *Cache) SetChannel(channel Channel) {
+	if cache.flags.Channels {
+		cacheSet(cache, "channel:"+channel.ID.String(), channel)
+	}
+}
+
+func (cache *Cache) DeleteChannel(id Snowflake) {
+	cache.store.Delete("channel:" + id.String())
+}
+
+func (cache *Cache) Guild(id Snowflake) (Guild, bool) {
+	if !cache.flags.Guilds {
+		return Guild{}, false
+	}
+	return cacheGet[Guild](cache, "guild:"+id.String())
+}
+
+// Caches guild and all of its roles (if roles caching is enabled).
+func (cache *Cache) SetGuild(guild Guild) {
+	if cache.flags.Guilds {
+		cacheSet(cache, "guild:"+guild.ID.String(), guild)
+	}
+
+	for _, role := range guild.Roles {
+		cache.SetRole(role)
+	}
+}
+
+func (cache *Cache) DeleteGuild(id Snowflake) {
+	cache.store.Delete("guild:" + id.String())
+}
+
+// Caches all users, members & roles included in interaction (invoker and resolved data).
+func (cache *Cache) storeInteraction(itx *Interaction, resolved *InteractionDataResolved) {
+	if itx.Member != nil && itx.Member.User != nil {
+		cache.SetUser(*itx.Member.User)
+		cache.SetMember(itx.GuildID, *itx.Member)
+	} else if itx.User != nil {
+		cache.SetUser(*itx.User)
+	}
+
+	if resolved == nil {
+		return
+	}
+
+	for _, user := range resolved.Users {
+		cache.SetUser(user)
+	}
+
+	if itx.GuildID != 0 {
+		for id, member := range resolved.Members {
+			if user, ok := resolved.Users[id]; ok {
+				member.User = &user
+				cache.SetMember(itx.GuildID, member)
+			}
+		}
+	}
+
+	for _, role := range resolved.Roles {
+		cache.SetRole(role)
+	}
+}
+
+func cacheGet[T any](cache *Cache, key string) (T, bool) {
+	var res T
+
+	raw, ok := cache.store.Get(key)
+	if !ok {
+		return res, false
+	}
+
+	if err := unmarshalJSON(raw, &res); err != nil {
+		cache.store.Delete(key)
+		return res, false
+	}
+
+	return res, true
+}
+
+func cacheSet(cache *Cache, key string, value any) {
+	raw, err := marshalJSON(value)
+	if err != nil {
+		return
+	}
+
+	cache.store.Set(key, raw, cache.ttl)
+}
+
+// Default CacheStore that keeps all values in process memory.
+// Expired entries are removed lazily on access - call Sweep from time to time to free memory used by entries that are never read again.
+type MemoryCacheStore struct {
+	items *SharedMap[string, memoryCacheItem]
+}
+
+type memoryCacheItem struct {
+	value     []byte
+	expiresAt time.Time // Zero means it never expires.
+}
+
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{
+		items: NewSharedMap[string, memoryCacheItem](),
+	}
+}
+
+func (store *MemoryCacheStore) Get(key string) ([]byte, bool) {
+	item, ok := store.items.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	if !item.expiresAt.IsZero() && time.Now().After(item.expiresAt) {
+		store.items.Delete(key)
+		return nil, false
+	}
+
+	return item.value, true
+}
+
+func (store *MemoryCacheStore) Set(key string, value []byte, ttl time.Duration) {
+	item := memoryCacheItem{value: value}
+	if ttl > 0 {
+		item.expiresAt = time.Now().Add(ttl)
+	}
+
+	store.items.Set(key, item)
+}
+
+func (store *MemoryCacheStore) SetIfAbsent(key string, value []byte, ttl time.Duration) bool {
+	item := memoryCacheItem{value: value}
+	if ttl > 0 {
+		item.expiresAt = time.Now().Add(ttl)
+	}
+
+	store.items.mu.Lock()
+	defer store.items.mu.Unlock()
+
+	if current, ok := store.items.cache[key]; ok && (current.expiresAt.IsZero() || time.Now().Before(current.expiresAt)) {
+		return false
+	}
+
+	store.items.cache[key] = item
+	return true
+}
+
+func (store *MemoryCacheStore) Delete(key string) {
+	store.items.Delete(key)
+}
+
+// Removes all expired entries.
+func (store *MemoryCacheStore) Sweep() {
+	now := time.Now()
+	store.items.Sweep(func(_ string, item memoryCacheItem) bool {
+		return !item.expiresAt.IsZero() && now.After(item.expiresAt)
+	})
+}
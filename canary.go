@@ -0,0 +1,80 @@
+package tempest
+
+import (
+	"errors"
+	"hash/fnv"
+	"slices"
+	"sync/atomic"
+)
+
+type CanaryOptions struct {
+	Percentage uint8       // Share of users (0-100) routed to canary handler. Each user always lands on the same side.
+	GuildIDs   []Snowflake // Guilds always routed to canary handler, regardless of percentage.
+}
+
+// CanaryRouter splits command traffic between stable (original) and canary handler, see Client.RegisterCanaryHandler.
+type CanaryRouter struct {
+	stable     func(itx *CommandInteraction) error
+	canary     func(itx *CommandInteraction) error
+	percentage atomic.Uint32
+	guildIDs   []Snowflake
+}
+
+// Adds second handler to already registered command (use "name@subcommand" for subcommands) and routes part of its traffic there.
+// Returned router can be used to change rollout percentage at runtime. Command stays the same on Discord side.
+func (client *Client) RegisterCanaryHandler(cmdName string, canary func(itx *CommandInteraction) error, opt CanaryOptions) (*CanaryRouter, error) {
+	if canary == nil {
+		return nil, errors.New("canary handler cannot be nil")
+	}
+
+	client.commands.mu.Lock()
+	defer client.commands.mu.Unlock()
+
+	cmd, ok := client.commands.cache[cmdName]
+	if !ok {
+		return nil, errors.New("missing \"" + cmdName + "\" slash command in registry (command needs to be registered in client before adding canary handler)")
+	}
+
+	if cmd.SlashCommandHandler == nil {
+		return nil, errors.New("\"" + cmdName + "\" slash command has no handler to compare canary against")
+	}
+
+	router := &CanaryRouter{
+		stable:   cmd.SlashCommandHandler,
+		canary:   canary,
+		guildIDs: slices.Clone(opt.GuildIDs),
+	}
+	router.SetPercentage(opt.Percentage)
+
+	cmd.SlashCommandHandler = router.handle
+	client.commands.cache[cmdName] = cmd
+	return router, nil
+}
+
+// Changes share of users (0-100) routed to canary handler. Use 0 to roll back or 100 to finish rollout.
+func (router *CanaryRouter) SetPercentage(percentage uint8) {
+	router.percentage.Store(uint32(min(percentage, 100)))
+}
+
+// Whether interaction would be handled by canary handler.
+func (router *CanaryRouter) IsCanary(itx *CommandInteraction) bool {
+	if itx.GuildID != 0 && slices.Contains(router.guildIDs, itx.GuildID) {
+		return true
+	}
+
+	percentage := router.percentage.Load()
+	if percentage == 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(itx.invokerID().String()))
+	return hash.Sum32()%100 < percentage
+}
+
+func (router *CanaryRouter) handle(itx *CommandInteraction) error {
+	if router.IsCanary(itx) {
+		return router.canary(itx)
+	}
+	return router.stable(itx)
+}
@@ -0,0 +1,142 @@
+package tempest
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// https://discord.com/developers/docs/reference#image-formatting-image-formats
+type ImageFormat string
+
+const (
+	PNG_IMAGE_FORMAT    ImageFormat = "png"
+	JPEG_IMAGE_FORMAT   ImageFormat = "jpg"
+	WEBP_IMAGE_FORMAT   ImageFormat = "webp"
+	GIF_IMAGE_FORMAT    ImageFormat = "gif"
+	LOTTIE_IMAGE_FORMAT ImageFormat = "json" // Only for stickers.
+)
+
+type ImageOptions struct {
+	Size   uint16      // Any power of 2 between 16 and 4096. Use 0 for Discord's default size.
+	Format ImageFormat // Defaults to GIF_IMAGE_FORMAT for animated assets (hash starts with "a_") and PNG_IMAGE_FORMAT otherwise.
+}
+
+// Returns url to user's avatar. Use empty hash to get default Discord's avatar.
+//
+// https://discord.com/developers/docs/reference#image-formatting-cdn-endpoints
+func UserAvatarURL(userID Snowflake, hash string, opt ImageOptions) string {
+	if hash == "" {
+		return DefaultAvatarURL(userID)
+	}
+	return cdnURL("/avatars/"+userID.String()+"/", hash, opt)
+}
+
+// Returns url to one of default Discord's avatars, assigned based on user ID.
+func DefaultAvatarURL(userID Snowflake) string {
+	return DISCORD_CDN_URL + "/embed/avatars/" + strconv.FormatUint(uint64(userID>>22)%6, 10) + ".png"
+}
+
+func UserBannerURL(userID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/banners/"+userID.String()+"/", hash, opt)
+}
+
+// Returns url to member's guild specific avatar.
+func MemberAvatarURL(guildID Snowflake, userID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/guilds/"+guildID.String()+"/users/"+userID.String()+"/avatars/", hash, opt)
+}
+
+// Returns url to member's guild specific banner.
+func MemberBannerURL(guildID Snowflake, userID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/guilds/"+guildID.String()+"/users/"+userID.String()+"/banners/", hash, opt)
+}
+
+func GuildIconURL(guildID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/icons/"+guildID.String()+"/", hash, opt)
+}
+
+func GuildBannerURL(guildID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/banners/"+guildID.String()+"/", hash, opt)
+}
+
+func GuildSplashURL(guildID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/splashes/"+guildID.String()+"/", hash, opt)
+}
+
+func GuildDiscoverySplashURL(guildID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/discovery-splashes/"+guildID.String()+"/", hash, opt)
+}
+
+func RoleIconURL(roleID Snowflake, hash string, opt ImageOptions) string {
+	return cdnURL("/role-icons/"+roleID.String()+"/", hash, opt)
+}
+
+// Returns url to custom emoji. Emojis have no hash so animation has to be passed explicitly.
+func EmojiURL(emojiID Snowflake, animated bool, opt ImageOptions) string {
+	if opt.Format == "" && animated {
+		opt.Format = GIF_IMAGE_FORMAT
+	}
+	return cdnURL("/emojis/", emojiID.String(), opt)
+}
+
+// Returns url to sticker, in format matching its type (Lottie stickers are JSON files).
+func StickerURL(stickerID Snowflake, format StickerFormatType, opt ImageOptions) string {
+	switch format {
+	case LOTTIE_STICKER_FORMAT_TYPE:
+		opt.Format = LOTTIE_IMAGE_FORMAT
+	case GIF_STICKER_FORMAT_TYPE:
+		// GIF stickers are only available through media proxy.
+		return strings.Replace(cdnURL("/stickers/", stickerID.String(), ImageOptions{Size: opt.Size, Format: GIF_IMAGE_FORMAT}), DISCORD_CDN_URL, DISCORD_MEDIA_URL, 1)
+	default:
+		opt.Format = PNG_IMAGE_FORMAT
+	}
+	return cdnURL("/stickers/", stickerID.String(), opt)
+}
+
+// Returns url to resized version of image attachment (through Discord's media proxy).
+// Width and height should keep original aspect ratio. Returns original url for attachments without proxy url.
+func (attachment Attachment) ResizedURL(width uint32, height uint32) string {
+	if attachment.ProxyURL == "" {
+		return attachment.URL
+	}
+
+	parsed, err := url.Parse(attachment.ProxyURL)
+	if err != nil {
+		return attachment.URL
+	}
+
+	query := parsed.Query()
+	query.Set("width", strconv.FormatUint(uint64(width), 10))
+	query.Set("height", strconv.FormatUint(uint64(height), 10))
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// Returns a direct url to emoji's image. It'll return empty string for unicode (not custom) emojis.
+func (emoji Emoji) URL() string {
+	if emoji.ID == 0 {
+		return ""
+	}
+	return EmojiURL(emoji.ID, emoji.Animated, ImageOptions{})
+}
+
+// Builds url to asset with given hash. Returns empty string if hash is empty.
+func cdnURL(path string, hash string, opt ImageOptions) string {
+	if hash == "" {
+		return ""
+	}
+
+	format := opt.Format
+	if format == "" {
+		format = PNG_IMAGE_FORMAT
+		if strings.HasPrefix(hash, "a_") {
+			format = GIF_IMAGE_FORMAT
+		}
+	}
+
+	res := DISCORD_CDN_URL + path + hash + "." + string(format)
+	if opt.Size != 0 {
+		res += "?size=" + strconv.FormatUint(uint64(opt.Size), 10)
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+package tempest
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const MAX_CHANNEL_NAME_LENGTH = 100
+
+// https://discord.com/developers/docs/resources/channel#overwrite-object-overwrite-structure
+type PermissionOverwriteType uint8
+
+const (
+	ROLE_PERMISSION_OVERWRITE_TYPE PermissionOverwriteType = iota
+	MEMBER_PERMISSION_OVERWRITE_TYPE
+)
+
+// https://discord.com/developers/docs/resources/channel#overwrite-object
+type PermissionOverwrite struct {
+	ID    Snowflake               `json:"id"` // Role or user ID.
+	Type  PermissionOverwriteType `json:"type"`
+	Allow PermissionFlags         `json:"allow,string"`
+	Deny  PermissionFlags         `json:"deny,string"`
+}
+
+// https://discord.com/developers/docs/resources/channel#channel-object-channel-structure
+type Channel struct {
+	ID                   Snowflake             `json:"id"`
+	Type                 ChannelType           `json:"type"`
+	GuildID              Snowflake             `json:"guild_id,omitempty"`
+	Position             uint16                `json:"position,omitempty"`
+	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites,omitzero"`
+	Name                 string                `json:"name,omitempty"`
+	Topic                string                `json:"topic,omitempty"`
+	NSFW                 bool                  `json:"nsfw,omitempty"`
+	LastMessageID        Snowflake             `json:"last_message_id,omitempty"`
+	Bitrate              uint32                `json:"bitrate,omitempty"`             // Bitrate (in bits) of the voice channel.
+	UserLimit            uint16                `json:"user_limit,omitempty"`          // User limit of the voice channel.
+	RateLimitPerUser     uint16                `json:"rate_limit_per_user,omitempty"` // Slowmode in seconds, 0-21600.
+	ParentID             Snowflake             `json:"parent_id,omitempty"`           // ID of parent category (or text channel for threads).
+	Flags                BitSet                `json:"flags,omitempty"`               // https://discord.com/developers/docs/resources/channel#channel-object-channel-flags
+	OwnerID              Snowflake             `json:"owner_id,omitempty"`            // Creator of thread (or forum post).
+	AvailableTags        []ForumTag            `json:"available_tags,omitzero"`       // Tags that can be applied to posts in forum or media channel.
+	AppliedTags          []Snowflake           `json:"applied_tags,omitzero"`         // Tags applied to forum or media post.
+	DefaultReactionEmoji *DefaultReaction      `json:"default_reaction_emoji,omitempty"`
+}
+
+func (channel Channel) Mention() string {
+	return "<#" + channel.ID.String() + ">"
+}
+
+// https://discord.com/developers/docs/resources/channel#followed-channel-object
+type FollowedChannel struct {
+	ChannelID Snowflake `json:"channel_id"` // Followed announcement channel.
+	WebhookID Snowflake `json:"webhook_id"` // Webhook that posts crossposted messages to target channel.
+}
+
+// https://discord.com/developers/docs/resources/guild#create-guild-channel-json-params
+type CreateChannelPayload struct {
+	Name                 string                `json:"name"`
+	Type                 ChannelType           `json:"type"`
+	Topic                string                `json:"topic,omitempty"`
+	Bitrate              uint32                `json:"bitrate,omitempty"`
+	UserLimit            uint16                `json:"user_limit,omitempty"`
+	RateLimitPerUser     uint16                `json:"rate_limit_per_user,omitempty"`
+	Position             uint16                `json:"position,omitempty"`
+	PermissionOverwrites []PermissionOverwrite `json:"permission_overwrites,omitzero"`
+	ParentID             Snowflake             `json:"parent_id,omitempty"`
+	NSFW                 bool                  `json:"nsfw,omitempty"`
+}
+
+// All fields are optional - only the ones you set will be modified.
+//
+// https://discord.com/developers/docs/resources/channel#modify-channel-json-params-guild-channel
+type ModifyChannelPayload struct {
+	Name                 string                  `json:"name,omitempty"`
+	Topic                Option[string]          `json:"topic,omitzero"`
+	Position             *uint16                 `json:"position,omitempty"`
+	NSFW                 *bool                   `json:"nsfw,omitempty"`
+	RateLimitPerUser     *uint16                 `json:"rate_limit_per_user,omitempty"`
+	PermissionOverwrites []PermissionOverwrite   `json:"permission_overwrites,omitzero"`
+	ParentID             Option[Snowflake]       `json:"parent_id,omitzero"`      // Use Null to move channel out of category.
+	AvailableTags        []ForumTag              `json:"available_tags,omitzero"` // Replaces all tags of forum or media channel (up to 20), check Client.SetForumTags.
+	AppliedTags          []Snowflake             `json:"applied_tags,omitzero"`   // Replaces tags applied to forum or media post (up to 5).
+	DefaultReactionEmoji Option[DefaultReaction] `json:"default_reaction_emoji,omitzero"`
+}
+
+// Reports whether Discord forces lowercase, dash separated names (like "general-chat") for given channel type.
+func isSlugChannelType(channelType ChannelType) bool {
+	switch channelType {
+	case GUILD_TEXT_CHANNEL_TYPE, GUILD_ANNOUNCEMENT_CHANNEL_TYPE, GUILD_FORUM_CHANNEL_TYPE, GUILD_MEDIA_CHANNEL_TYPE:
+		return true
+	}
+	return false
+}
+
+// Converts name into form Discord would store for given channel type. For text-like channels it lowercases name,
+// replaces whitespace with dashes, removes unsupported characters and collapses repeated dashes.
+// Result is always trimmed to MAX_CHANNEL_NAME_LENGTH characters.
+func NormalizeChannelName(name string, channelType ChannelType) string {
+	name = strings.TrimSpace(name)
+
+	if isSlugChannelType(channelType) {
+		var sb strings.Builder
+		lastDash := true // Avoids leading dash.
+
+		for _, r := range strings.ToLower(name) {
+			switch {
+			case unicode.IsSpace(r) || r == '-':
+				if !lastDash {
+					sb.WriteRune('-')
+					lastDash = true
+				}
+			case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || unicode.Is(unicode.So, r):
+				sb.WriteRune(r)
+				lastDash = false
+			}
+		}
+
+		name = strings.TrimSuffix(sb.String(), "-")
+	}
+
+	if utf8.RuneCountInString(name) > MAX_CHANNEL_NAME_LENGTH {
+		name = string([]rune(name)[:MAX_CHANNEL_NAME_LENGTH])
+	}
+
+	return name
+}
+
+// Checks whether name can be used for given channel type without Discord rejecting or changing it.
+func ValidateChannelName(name string, channelType ChannelType) error {
+	length := utf8.RuneCountInString(name)
+	if length == 0 {
+		return errors.New("channel name cannot be empty")
+	}
+
+	if length > MAX_CHANNEL_NAME_LENGTH {
+		return errors.New("channel name cannot be longer than 100 characters")
+	}
+
+	if isSlugChannelType(channelType) && NormalizeChannelName(name, channelType) != name {
+		return errors.New("channel name \"" + name + "\" has to be lowercase & dash separated, use NormalizeChannelName")
+	}
+
+	return nil
+}
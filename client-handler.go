@@ -1,48 +1,170 @@
 package tempest
 
 import (
+	"context"
 	"crypto/ed25519"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"runtime/debug"
 	"net/http"
+	"runtime/debug"
+	"time"
 )
 
+// Handles incoming Discord interaction requests - it's an equivalent of client.ServeHTTP.
 func (client *Client) DiscordRequestHandler(w http.ResponseWriter, r *http.Request) {
-	verified := verifyRequest(r, ed25519.PublicKey(client.PublicKey))
-	if !verified {
+	client.ServeHTTP(w, r)
+}
+
+// Makes Client a regular http.Handler so it can be wrapped with any standard middleware.
+// It runs all 3 stages in order: VerifyRequest -> ParseInteraction -> DispatchInteraction.
+// Requests from outside of ClientOptions.AllowedSources are rejected before that, just like all requests after Client.Shutdown.
+func (client *Client) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !client.lifecycle.begin() {
+		http.Error(w, "service unavailable - shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	defer client.lifecycle.end()
+
+	if !client.IsAllowedSource(r) {
+		client.logger.Debug("rejected request from disallowed source", "ip", client.RealIP(r))
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	if !client.VerifyRequest(r) {
 		http.Error(w, "unauthorized", http.StatusUnauthorized)
 		return
 	}
 
+	interaction, err := client.ParseInteraction(w, r)
+	if err != nil {
+		http.Error(w, "bad request - "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if client.forwardInteraction(w, interaction) {
+		return
+	}
+
+	client.DispatchInteraction(w, interaction)
+}
+
+// Checks whether request was signed by Discord with your app's public key.
+// Request body stays readable after verification.
+func (client *Client) VerifyRequest(r *http.Request) bool {
+	return verifyRequest(r, ed25519.PublicKey(client.PublicKey))
+}
+
+// Reads request body (up to MAX_REQUEST_BODY_SIZE bytes) and decodes it into interaction.
+// It does not verify request signature - use client.VerifyRequest before calling it.
+func (client *Client) ParseInteraction(w http.ResponseWriter, r *http.Request) (Interaction, error) {
 	limitedReader := http.MaxBytesReader(w, r.Body, MAX_REQUEST_BODY_SIZE)
-	rawData, err := io.ReadAll(limitedReader)
+	buf, err := readPooled(limitedReader)
+	defer releaseBuffer(buf)
 	limitedReader.Close() // closes underlying r.Body
 	if err != nil {
-		http.Error(w, "bad request - failed to read body payload", http.StatusBadRequest)
-		return
+		return Interaction{}, errors.New("failed to read body payload")
 	}
 
+	rawData := buf.Bytes()
+
 	var interaction Interaction
-	if err := json.Unmarshal(rawData, &interaction); err != nil {
-		http.Error(w, "bad request - invalid body json payload", http.StatusBadRequest)
-		return
+	if err := unmarshalJSON(rawData, &interaction); err != nil {
+		return Interaction{}, errors.New("invalid body json payload")
 	}
+
+	parent := context.WithoutCancel(r.Context())
+	if client.cancelOnDisconnect {
+		parent = r.Context()
+	}
+
+	client.logger.Debug("received interaction", "id", interaction.ID, "ip", client.RealIP(r), "type", interaction.Type, "guild_id", interaction.GuildID, "guild", client.guildName(interaction.GuildID))
+	client.prepareInteraction(parent, &interaction, rawData)
+	client.captureInteraction(&interaction, rawData)
+	return interaction, nil
+}
+
+// Binds decoded interaction to client & runs optional reporting of unknown fields.
+func (client *Client) prepareInteraction(parent context.Context, interaction *Interaction, rawData []byte) {
+	// Context has to outlive this request (for follow-ups), it's released once its deadline passes.
+	interaction.ctx, interaction.cancel = context.WithTimeout(parent, client.interactionTimeout)
 	interaction.Client = client
+	interaction.payloadSize = len(rawData)
+
+	if client.reportUnknown {
+		client.reportUnknownFields(rawData, *interaction)
+	}
+}
+
+// Logs fields of interaction (and its data) that tempest structs don't know about.
+func (client *Client) reportUnknownFields(rawData []byte, interaction Interaction) {
+	var data any
+	switch interaction.Type {
+	case APPLICATION_COMMAND_INTERACTION_TYPE, APPLICATION_COMMAND_AUTO_COMPLETE_INTERACTION_TYPE:
+		data = &CommandInteractionData{}
+	case MESSAGE_COMPONENT_INTERACTION_TYPE:
+		data = &ComponentInteractionData{}
+	case MODAL_SUBMIT_INTERACTION_TYPE:
+		data = &ModalInteractionData{}
+	}
+
+	var fields []string
+	var unknown *UnknownFieldsError
+	if err := UnmarshalStrict(rawData, &Interaction{}); errors.As(err, &unknown) {
+		fields = append(fields, unknown.Fields...)
+	}
+
+	if data != nil && len(interaction.Data) > 0 {
+		if err := UnmarshalStrict(interaction.Data, data); errors.As(err, &unknown) {
+			for _, field := range unknown.Fields {
+				fields = append(fields, "data."+field)
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		client.logger.Warn("received interaction with unknown fields", "id", interaction.ID, "type", interaction.Type, "fields", fields)
+	}
+}
+
+// Routes parsed interaction to matching handler & writes initial response.
+func (client *Client) DispatchInteraction(w http.ResponseWriter, interaction Interaction) {
+	interaction.Client = client
+	defer func() {
+		if r := recover(); r != nil {
+			client.handlePanic(&interaction, r, debug.Stack())
+		}
+	}()
+
+	if interaction.Type != PING_INTERACTION_TYPE {
+		if !client.claimInteraction(interaction.ID) {
+			client.logger.Debug("ignored duplicate interaction", "id", interaction.ID, "type", interaction.Type)
+			interaction.Release()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		client.observeInteractionCreate(&interaction)
+	}
 
 	switch interaction.Type {
 	case PING_INTERACTION_TYPE:
+		interaction.Release()
 		w.Header().Add("Content-Type", CONTENT_TYPE_JSON)
 		w.Write(bodyPingResponse)
 		return
 	case APPLICATION_COMMAND_INTERACTION_TYPE:
 		var data CommandInteractionData
-		if err := json.Unmarshal(interaction.Data, &data); err != nil {
+		if err := client.unmarshalData(interaction.Data, &data); err != nil {
+			interaction.Release()
 			http.Error(w, "bad request - failed to decode Interaction.Data", http.StatusBadRequest)
 			return
 		}
 
+		if client.Cache != nil {
+			client.Cache.storeInteraction(&interaction, data.Resolved)
+		}
+
 		client.commandInteractionHandler(w, CommandInteraction{
 			Interaction: &interaction,
 			Data:        data,
@@ -50,11 +172,16 @@ func (client *Client) DiscordRequestHandler(w http.ResponseWriter, r *http.Reque
 		return
 	case MESSAGE_COMPONENT_INTERACTION_TYPE:
 		var data ComponentInteractionData
-		if err := json.Unmarshal(interaction.Data, &data); err != nil {
+		if err := unmarshalJSON(interaction.Data, &data); err != nil {
+			interaction.Release()
 			http.Error(w, "bad request - failed to decode Interaction.Data", http.StatusBadRequest)
 			return
 		}
 
+		if client.Cache != nil {
+			client.Cache.storeInteraction(&interaction, data.Resolved)
+		}
+
 		client.componentInteractionHandler(w, ComponentInteraction{
 			Interaction: &interaction,
 			Data:        data,
@@ -62,8 +189,10 @@ func (client *Client) DiscordRequestHandler(w http.ResponseWriter, r *http.Reque
 		})
 		return
 	case APPLICATION_COMMAND_AUTO_COMPLETE_INTERACTION_TYPE:
+		defer interaction.Release() // Autocomplete can't be followed up.
 		var data CommandInteractionData
-		if err := json.Unmarshal(interaction.Data, &data); err != nil {
+		if err := client.unmarshalData(interaction.Data, &data); err != nil {
+			interaction.Release()
 			http.Error(w, "bad request - failed to decode Interaction.Data", http.StatusBadRequest)
 			return
 		}
@@ -75,11 +204,16 @@ func (client *Client) DiscordRequestHandler(w http.ResponseWriter, r *http.Reque
 		return
 	case MODAL_SUBMIT_INTERACTION_TYPE:
 		var data ModalInteractionData
-		if err := json.Unmarshal(interaction.Data, &data); err != nil {
+		if err := unmarshalJSON(interaction.Data, &data); err != nil {
+			interaction.Release()
 			http.Error(w, "bad request - failed to decode Interaction.Data", http.StatusBadRequest)
 			return
 		}
 
+		if client.Cache != nil {
+			client.Cache.storeInteraction(&interaction, data.Resolved)
+		}
+
 		client.modalInteractionHandler(w, ModalInteraction{
 			Interaction: &interaction,
 			Data:        data,
@@ -91,25 +225,48 @@ func (client *Client) DiscordRequestHandler(w http.ResponseWriter, r *http.Reque
 
 func (client *Client) commandInteractionHandler(w http.ResponseWriter, interaction CommandInteraction) {
 	itx, command, available := client.handleInteraction(interaction)
+	defer client.observeInteraction(itx.Interaction, itx.Data.Name, time.Now())
+
 	if !available {
+		client.logger.Debug("received unknown command", "name", itx.Data.Name)
+		itx.Release()
 		w.Header().Add("Content-Type", CONTENT_TYPE_JSON)
 		w.Write(bodyUnknownCommandResponse)
 		return
 	}
 
+	if client.IsCommandDisabled(itx.Data.Name) {
+		client.logger.Debug("received disabled command", "name", itx.Data.Name)
+		itx.Release()
+		w.Header().Add("Content-Type", CONTENT_TYPE_JSON)
+		w.Write(client.disabledCommandResponse)
+		return
+	}
+
+	client.logger.Debug("dispatching command", "name", itx.Data.Name, "id", itx.ID)
+
 	w.WriteHeader(http.StatusNoContent)
 	itx.Client = client
 
+	for _, middleware := range client.commandMiddlewares {
+		if !middleware(command, &itx) {
+			itx.Release()
+			return
+		}
+	}
+
 	if client.preCommandHandler != nil && !client.preCommandHandler(command, &itx) {
+		itx.Release()
 		return
 	}
 
 	defer func() {
 		if r := recover(); r != nil {
+			stack := debug.Stack()
 			if client.errorCommandHandler != nil {
-				stack := debug.Stack()
 				client.errorCommandHandler(command, fmt.Errorf("panic occurred: %v\n%s", r, stack), &itx)
 			}
+			client.handlePanic(itx.Interaction, r, stack)
 		}
 	}()
 
@@ -127,13 +284,15 @@ func (client *Client) commandInteractionHandler(w http.ResponseWriter, interacti
 
 func (client *Client) autoCompleteInteractionHandler(w http.ResponseWriter, interaction CommandInteraction) {
 	itx, command, available := client.handleInteraction(interaction)
+	defer client.observeInteraction(itx.Interaction, itx.Data.Name, time.Now())
+
 	if !available {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
 	choices := command.AutoCompleteHandler(itx)
-	body, err := json.Marshal(ResponseAutoComplete{
+	body, err := marshalJSON(ResponseAutoComplete{
 		Type: AUTOCOMPLETE_RESPONSE_TYPE,
 		Data: &ResponseAutoCompleteData{
 			Choices: choices,
@@ -150,6 +309,9 @@ func (client *Client) autoCompleteInteractionHandler(w http.ResponseWriter, inte
 }
 
 func (client *Client) componentInteractionHandler(w http.ResponseWriter, interaction ComponentInteraction) {
+	client.logger.Debug("dispatching component", "custom_id", interaction.Data.CustomID, "id", interaction.ID)
+	defer client.observeInteraction(interaction.Interaction, interaction.Data.CustomID, time.Now())
+
 	if fn, ok := client.staticComponents.Get(interaction.Data.CustomID); ok {
 		fn(interaction)
 		return
@@ -164,16 +326,45 @@ func (client *Client) componentInteractionHandler(w http.ResponseWriter, interac
 			// Successfully sent
 		default:
 			// Receiver gone, drop silently
+			interaction.Release()
 		}
 		return
 	}
 
+	if interaction.Message != nil {
+		client.messageCollectors.mu.RLock()
+		signalChan, ok := client.messageCollectors.cache[interaction.Message.ID]
+		if ok {
+			w.Header().Set("Content-Type", CONTENT_TYPE_JSON)
+			w.Write(bodyAcknowledgeResponse)
+
+			select {
+			case signalChan <- &interaction:
+			default:
+				// Collector is full or nobody reads it - interaction was already acknowledged, so at least leave a trace.
+				client.logger.Warn("dropped component interaction - collector is full", "id", interaction.ID, "message_id", interaction.Message.ID, "custom_id", interaction.Data.CustomID)
+				interaction.Release()
+			}
+		}
+		client.messageCollectors.mu.RUnlock()
+
+		if ok {
+			return
+		}
+	}
+
 	if client.componentHandler != nil {
 		client.componentHandler(&interaction)
+		return
 	}
+
+	interaction.Release()
 }
 
 func (client *Client) modalInteractionHandler(w http.ResponseWriter, interaction ModalInteraction) {
+	client.logger.Debug("dispatching modal", "custom_id", interaction.Data.CustomID, "id", interaction.ID)
+	defer client.observeInteraction(interaction.Interaction, interaction.Data.CustomID, time.Now())
+
 	fn, available := client.staticModals.Get(interaction.Data.CustomID)
 	if available {
 		fn(interaction)
@@ -190,5 +381,55 @@ func (client *Client) modalInteractionHandler(w http.ResponseWriter, interaction
 
 	if client.modalHandler != nil {
 		client.modalHandler(&interaction)
+		return
+	}
+
+	interaction.Release()
+}
+
+// Logs recovered panic, calls ClientOptions.OnPanic and lets user know about failure if PanicMessage is set.
+func (client *Client) handlePanic(itx *Interaction, recovered any, stack []byte) {
+	client.logger.Error("recovered panic in interaction handler", "id", itx.ID, "type", itx.Type, "panic", recovered)
+
+	if client.panicHandler != nil {
+		client.panicHandler(itx, recovered, stack)
+	}
+
+	if client.panicMessage == "" || itx.Type == PING_INTERACTION_TYPE || itx.Type == APPLICATION_COMMAND_AUTO_COMPLETE_INTERACTION_TYPE {
+		return
+	}
+
+	data := ResponseMessageData{Content: client.panicMessage, Flags: EPHEMERAL_MESSAGE_FLAG}
+	_, err := client.Rest.RequestWithContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
+		Type: CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
+		Data: &data,
+	})
+
+	if IsAlreadyAcknowledged(err) {
+		_, err = itx.webhookRequest(http.MethodPost, "", data)
+	}
+
+	if err != nil {
+		client.logger.Warn("failed to send panic message", "id", itx.ID, "error", err)
+	}
+}
+
+func (client *Client) observeInteraction(itx *Interaction, name string, start time.Time) {
+	client.metrics.ObserveInteraction(itx.Type, name, time.Since(start))
+
+	if pm, ok := client.metrics.(PayloadMetrics); ok {
+		pm.ObservePayloadSize(itx.Type, name, itx.payloadSize)
+	}
+
+	if client.payloadStats != nil {
+		client.recordPayloadSize(itx.Type, name, itx.payloadSize)
+	}
+}
+
+// Decodes interaction data, respecting ClientOptions.UseJSONNumber setting.
+func (client *Client) unmarshalData(data []byte, v any) error {
+	if client.useJSONNumber {
+		return UnmarshalWithNumbers(data, v)
 	}
+	return unmarshalJSON(data, v)
 }
@@ -0,0 +1,63 @@
+package tempest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Compares ParseInteraction with pooled body buffers against reading each body into fresh slice, like it did before.
+func BenchmarkParseInteraction(b *testing.B) {
+	payload, err := os.ReadFile("test/fixtures/interaction-command.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	client := NewClient(ClientOptions{
+		Token:     base64.RawStdEncoding.EncodeToString([]byte("1144027356181467136")) + ".fake.token",
+		PublicKey: strings.Repeat("00", 32),
+	})
+	w := httptest.NewRecorder()
+
+	b.Run("unpooled", func(b *testing.B) {
+		b.SetBytes(int64(len(payload)))
+		b.ReportAllocs()
+
+		for b.Loop() {
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+			limitedReader := http.MaxBytesReader(w, r.Body, MAX_REQUEST_BODY_SIZE)
+			rawData, err := io.ReadAll(limitedReader)
+			limitedReader.Close()
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			var interaction Interaction
+			if err := unmarshalJSON(rawData, &interaction); err != nil {
+				b.Fatal(err)
+			}
+
+			client.prepareInteraction(r.Context(), &interaction, rawData)
+			interaction.Release()
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		b.SetBytes(int64(len(payload)))
+		b.ReportAllocs()
+
+		for b.Loop() {
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(payload))
+			interaction, err := client.ParseInteraction(w, r)
+			if err != nil {
+				b.Fatal(err)
+			}
+			interaction.Release()
+		}
+	})
+}
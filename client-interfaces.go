@@ -0,0 +1,103 @@
+package tempest
+
+import "time"
+
+// Interfaces below split Client into smaller capabilities. Accept them in your own code instead of *Client
+// so you can replace single capability with mock in unit tests, without stubbing the whole Rest layer.
+
+// Sends and manages messages in channels & DMs.
+type MessageSender interface {
+	SendMessage(channelID Snowflake, message Message, files []File) (Message, error)
+	SendLinearMessage(channelID Snowflake, content string) (Message, error)
+	SendPrivateMessage(userID Snowflake, content Message, files []File) (Message, error)
+	EditMessage(channelID Snowflake, messageID Snowflake, content Message) error
+	DeleteMessage(channelID Snowflake, messageID Snowflake) error
+	CrosspostMessage(channelID Snowflake, messageID Snowflake) error
+}
+
+type UserFetcher interface {
+	FetchUser(id Snowflake) (User, error)
+}
+
+type MemberFetcher interface {
+	FetchMember(guildID Snowflake, memberID Snowflake) (Member, error)
+	StreamMembers(guildID Snowflake, limit uint16, after Snowflake, fn func(member Member) error) error
+}
+
+type MessageFetcher interface {
+	StreamMessages(channelID Snowflake, limit uint8, before Snowflake, fn func(message Message) error) error
+}
+
+type GuildManager interface {
+	FetchGuild(guildID Snowflake) (Guild, error)
+	ModifyGuild(guildID Snowflake, payload ModifyGuildPayload) (Guild, error)
+	SetPremiumProgressBar(guildID Snowflake, enabled bool) (Guild, error)
+}
+
+// Automates community setup: guild templates, onboarding & welcome screen.
+type GuildSetupManager interface {
+	FetchGuildTemplates(guildID Snowflake) ([]GuildTemplate, error)
+	CreateGuildTemplate(guildID Snowflake, payload GuildTemplatePayload) (GuildTemplate, error)
+	SyncGuildTemplate(guildID Snowflake, code string) (GuildTemplate, error)
+	FetchOnboarding(guildID Snowflake) (Onboarding, error)
+	ModifyOnboarding(guildID Snowflake, onboarding Onboarding) (Onboarding, error)
+	FetchWelcomeScreen(guildID Snowflake) (WelcomeScreen, error)
+	ModifyWelcomeScreen(guildID Snowflake, payload ModifyWelcomeScreenPayload) (WelcomeScreen, error)
+}
+
+type ChannelManager interface {
+	FetchChannel(channelID Snowflake) (Channel, error)
+	CreateChannel(guildID Snowflake, payload CreateChannelPayload) (Channel, error)
+	ModifyChannel(channelID Snowflake, payload ModifyChannelPayload) (Channel, error)
+}
+
+type EntitlementManager interface {
+	FetchEntitlementsPage(queryFilter string) ([]Entitlement, error)
+	FetchEntitlement(entitlementID Snowflake) (Entitlement, error)
+	ConsumeEntitlement(entitlementID Snowflake) error
+	CreateTestEntitlement(payload TestEntitlementPayload) error
+	DeleteTestEntitlement(entitlementID Snowflake) error
+}
+
+// Registers commands, components & modals handled by app.
+type CommandRegistry interface {
+	RegisterCommand(cmd Command) error
+	RegisterSubCommand(subCommand Command, parentCommandName string) error
+	RegisterStructCommand(cmd Command, v StructCommand) error
+	RegisterStructSubCommand(subCommand Command, v StructCommand, parentCommandName string) error
+	RegisterComponent(customIDs []string, fn func(ComponentInteraction)) error
+	RegisterModal(customID string, fn func(ModalInteraction)) error
+	UnregisterCommand(cmdName string) bool
+	FindCommand(cmdName string) (Command, bool)
+	DisableCommand(cmdName string)
+	EnableCommand(cmdName string)
+	IsCommandDisabled(cmdName string) bool
+}
+
+// Keeps commands stored by Discord in sync with locally registered ones.
+type CommandSyncer interface {
+	SyncCommandsWithDiscord(guildIDs []Snowflake, whitelist []string, reverseMode bool) error
+	ReplaceCommands(commands []Command, guildIDs []Snowflake) error
+	FetchCommands(guildID Snowflake) ([]Command, error)
+	DetectCommandDrift(guildID Snowflake) ([]CommandDrift, error)
+}
+
+type ComponentAwaiter interface {
+	AwaitComponent(customIDs []string) (<-chan *ComponentInteraction, func(), error)
+	AwaitModal(customIDs []string) (<-chan *ModalInteraction, func(), error)
+	NewCollector(messageID Snowflake, timeout time.Duration) (<-chan *ComponentInteraction, func())
+}
+
+var (
+	_ MessageSender      = (*Client)(nil)
+	_ UserFetcher        = (*Client)(nil)
+	_ MemberFetcher      = (*Client)(nil)
+	_ MessageFetcher     = (*Client)(nil)
+	_ GuildManager       = (*Client)(nil)
+	_ GuildSetupManager  = (*Client)(nil)
+	_ ChannelManager     = (*Client)(nil)
+	_ EntitlementManager = (*Client)(nil)
+	_ CommandRegistry    = (*Client)(nil)
+	_ CommandSyncer      = (*Client)(nil)
+	_ ComponentAwaiter   = (*Client)(nil)
+)
@@ -1,14 +1,19 @@
 package tempest
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/netip"
+	"strconv"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 // Client is the core tempest entrypoint
@@ -16,32 +21,114 @@ type Client struct {
 	ApplicationID Snowflake
 	PublicKey     ed25519.PublicKey
 	Rest          *Rest
+	Cache         *Cache         // Optional cache for Discord entities, it's nil unless enabled with ClientOptions.Cache.
+	Names         *NameCache     // Optional guild & channel names for logs, it's nil unless enabled with ClientOptions.NameCache.
+	Scheduler     *TaskScheduler // Runs background tasks on interval or cron schedule. It's stopped by Client.Shutdown.
+
+	User             User             // Bot's own user account, filled by Client.TestConnection.
+	ApplicationFlags ApplicationFlags // Application flags (like enabled privileged intents), filled by Client.TestConnection.
 
 	commands         *SharedMap[string, Command]
 	commandContexts  []InteractionContextType
+	integrationTypes []ApplicationIntegrationType
 	staticComponents *SharedMap[string, func(ComponentInteraction)]
 	staticModals     *SharedMap[string, func(ModalInteraction)]
 
+	commandMiddlewares  []CommandMiddleware
 	preCommandHandler   func(cmd Command, itx *CommandInteraction) bool
 	postCommandHandler  func(cmd Command, itx *CommandInteraction)
 	errorCommandHandler func(cmd Command, err error, itx *CommandInteraction)
 	componentHandler    func(itx *ComponentInteraction)
 	modalHandler        func(itx *ModalInteraction)
+	panicHandler        func(itx *Interaction, recovered any, stack []byte)
+	panicMessage        string
 
 	queuedComponents *SharedMap[string, chan *ComponentInteraction]
 	queuedModals     *SharedMap[string, chan *ModalInteraction]
+
+	messageCollectors *SharedMap[Snowflake, chan *ComponentInteraction]
+
+	disabledCommands        *SharedMap[string, struct{}]
+	disabledCommandsStore   CacheStore
+	dedupStore              CacheStore
+	disabledCommandResponse []byte
+
+	useJSONNumber bool
+	reportUnknown bool
+	logger        *slog.Logger
+	metrics       Metrics
+	payloadStats  *SharedMap[payloadStatsKey, InteractionPayloadStats]
+	translator    Translator
+
+	interactionTimeout time.Duration
+	cancelOnDisconnect bool
+
+	events    *eventDispatcher
+	publisher EventPublisher
+	resources *ResourceRegistry
+	lifecycle *lifecycle
+	modules   *moduleRegistry
+
+	maxDownloadSize int64
+
+	publishOnly         bool
+	publishInteractions bool
+
+	trustedProxies []netip.Prefix
+	realIPHeader   string
+	allowedSources []netip.Prefix
 }
 
 type ClientOptions struct {
 	Token                      string
+	TokenProvider              TokenProvider // Optional source of up to date bot token (for rotating credentials), called before each request. Token is still used to read application ID.
 	PublicKey                  string
 	DefaultInteractionContexts []InteractionContextType
-
+	DefaultIntegrationTypes    []ApplicationIntegrationType
+	Cache                      *Cache               // Optional cache populated from REST responses & received interactions. Create it with NewCache.
+	NameCache                  *NameCache           // Optional guild & channel name cache populated from REST responses, used to make logs readable. Create it with NewNameCache.
+	Metrics                    Metrics              // Optional receiver of request, rate limit & interaction latency measurements.
+	UnauthorizedHandler        func(err *RestError) // Optional function called whenever Discord API rejects bot token. Check Rest.UnauthorizedHandler.
+	OnAPIDegraded              func(err *RestError) // Optional function called once Discord API starts failing with 502/503/504 (like during maintenance). Check Rest.DegradedCooldown.
+	Logger                     *slog.Logger         // Optional logger for debug information about requests, rate limits & dispatched interactions.
+	InteractionTimeout         time.Duration        // Deadline of context passed to handlers (check Interaction.Context), counted from receiving interaction. Defaults to 15 minutes - lifetime of interaction token.
+	CancelOnDisconnect         bool                 // Whether handler context should be cancelled once Discord closes HTTP request. By default context only keeps request values so follow-up work isn't interrupted after initial response.
+	DisabledCommandsStore      CacheStore           // Optional store shared between replicas, used by Client.DisableCommand. It allows to disable commands on all instances (or directly in store, under "disabled-command:<name>" key).
+	DisabledCommandMessage     string               // Ephemeral message sent instead of running disabled command. Defaults to "This command is temporarily disabled.".
+	ResourceRegistry           *ResourceRegistry    // Optional registry of resources created by bot. Channels created with Client.CreateChannel are recorded automatically.
+	Translator                 Translator           // Optional translator used by Interaction.Translate to localize responses. Check MapTranslator for simple one.
+	TrackPayloadSizes          bool                 // Whether to keep payload size stats per command/component, check Client.LargestInteractions. Avoid it with dynamic custom IDs as each unique ID gets own entry.
+	TrustedProxies             []string             // CIDR ranges (or single IPs) of reverse proxies (like Cloudflare) allowed to set RealIPHeader.
+	RealIPHeader               string               // Header with original client IP set by trusted proxy, like "CF-Connecting-IP" or "X-Forwarded-For". Check Client.RealIP.
+	AllowedSources             []string             // Optional allowlist of CIDR ranges (or single IPs) that can reach interaction endpoint, checked against real IP. Leave empty to allow any source.
+	MaxDownloadSize            int64                // Largest attachment (in bytes) accepted by Client.DownloadAttachment. Defaults to 25 MB, use negative value for no limit.
+	HTTPClient                 *http.Client         // Optional HTTP client used for Discord API requests (copied), for example with Timeout or proxy settings. Defaults to http.DefaultClient (without timeout, check Rest.Timeouts).
+	Transport                  http.RoundTripper    // Optional transport for Discord API requests, like *http.Transport with Proxy or tuned connection pool. Takes priority over HTTPClient.Transport.
+	ConditionalStore           CacheStore           // Optional store of ETag/Last-Modified validators, so refetched GET routes (like guild roles) return cached body on 304. Check Rest.ConditionalStore.
+	CoalesceRequests           bool                 // Whether concurrent GET requests to the same route should share single HTTP request. Check Rest.CoalesceRequests.
+	RequestTimeout             time.Duration        // Optional timeout for Discord API requests without files. Check Rest.RequestTimeout.
+	UploadTimeout              time.Duration        // Optional timeout for Discord API requests with files, usually much longer than RequestTimeout. Check Rest.UploadTimeout.
+	APIURL                     string               // Optional API URL without version (like "http://localhost:8080/api" for nirn-proxy), check Rest.BaseURL.
+	APIVersion                 uint8                // Optional API version, defaults to DISCORD_API_VERSION.
+	RestProxy                  bool                 // Whether APIURL points to rate limit aware proxy, so local rate limiting is skipped. Check Rest.ProxyMode.
+	UseJSONNumber              bool                 // Whether to decode dynamic values (like CommandInteractionOption.Value) as json.Number instead of float64. Enable it if you need to read large integers without losing precision.
+	ReportUnknownFields        bool                 // Whether to log warning whenever received interaction has fields unknown to tempest structs (check UnmarshalStrict). Interactions are still handled, it only costs extra decoding.
+	EventWorkers               int                  // Optional number of goroutines handling webhook events (check Client.EventHandler). By default each event runs on its own goroutine.
+	EventQueueSize             int                  // Number of webhook events waiting for free worker before EventOverflow applies. Defaults to 100 when EventWorkers is set.
+	EventOverflow              EventOverflowPolicy  // What to do with webhook events once queue is full, defaults to BLOCK_EVENT_OVERFLOW.
+	EventPublisher             EventPublisher       // Optional message broker that receives every webhook event (under EVENT_SUBJECT_PREFIX + type). Events are still handled locally unless PublishOnly is set.
+	PublishOnly                bool                 // Whether received webhook events (and interactions, with PublishInteractions) should only be published, for workers consuming them with Client.ConsumeEvent & Client.ConsumeInteraction. Failed publish is answered with 503.
+	PublishInteractions        bool                 // Whether to also publish every received interaction payload (under INTERACTION_SUBJECT_PREFIX + type). Without PublishOnly it's only a copy - interactions are still handled locally.
+	DedupStore                 CacheStore           // Optional store of handled interaction IDs, so interactions retried by Discord don't run handlers twice. Share it between replicas (like RedisStore) to suppress duplicates across them.
+
+	CommandMiddlewares  []CommandMiddleware                                   // Functions that run (in order) before PreCommandHook, for example Cooldown.Middleware. Any of them can stop command execution.
 	PreCommandHook      func(cmd Command, itx *CommandInteraction) bool       // Function that runs before each command. Return type signals whether to continue command execution (return with false to stop early).
 	PostCommandHook     func(cmd Command, itx *CommandInteraction)            // Function that runs after each command.
 	ErrorCommandHandler func(cmd Command, err error, itx *CommandInteraction) // Funtion that runs instead of PostCommandHook if command failed.
 	ComponentHandler    func(itx *ComponentInteraction)                       // Function that runs for each unhandled component.
 	ModalHandler        func(itx *ModalInteraction)                           // Function that runs for each unhandled modal.
+	OnPanic             func(itx *Interaction, recovered any, stack []byte)   // Function that runs whenever any interaction handler panics. Panics are always recovered.
+	PanicMessage        string                                                // Optional ephemeral message sent to user when handler panics (as reply or follow-up if already acknowledged). Leave empty to stay silent.
 }
 
 func NewClient(opt ClientOptions) Client {
@@ -50,6 +137,16 @@ func NewClient(opt ClientOptions) Client {
 		panic("failed to decode discord's public key (check if it's correct key): " + err.Error())
 	}
 
+	trustedProxies, err := parsePrefixes(opt.TrustedProxies)
+	if err != nil {
+		panic("failed to parse trusted proxies: " + err.Error())
+	}
+
+	allowedSources, err := parsePrefixes(opt.AllowedSources)
+	if err != nil {
+		panic("failed to parse allowed sources: " + err.Error())
+	}
+
 	botUserID, err := extractUserIDFromToken(opt.Token)
 	if err != nil {
 		panic("failed to extract bot user ID from bot token: " + err.Error())
@@ -60,21 +157,112 @@ func NewClient(opt ClientOptions) Client {
 		contexts = opt.DefaultInteractionContexts
 	}
 
+	logger := opt.Logger
+	if logger == nil {
+		logger = discardLogger
+	}
+
+	metrics := opt.Metrics
+	if metrics == nil {
+		metrics = NoopMetrics{}
+	}
+
+	disabledCommandMessage := opt.DisabledCommandMessage
+	if disabledCommandMessage == "" {
+		disabledCommandMessage = "This command is temporarily disabled."
+	}
+
+	disabledCommandResponse, err := marshalJSON(ResponseMessage{
+		Type: CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
+		Data: &ResponseMessageData{Content: disabledCommandMessage, Flags: EPHEMERAL_MESSAGE_FLAG},
+	})
+	if err != nil {
+		panic("failed to encode disabled command message: " + err.Error())
+	}
+
+	interactionTimeout := opt.InteractionTimeout
+	if interactionTimeout == 0 {
+		interactionTimeout = INTERACTION_TOKEN_LIFETIME
+	}
+
+	maxDownloadSize := opt.MaxDownloadSize
+	if maxDownloadSize == 0 {
+		maxDownloadSize = DEFAULT_MAX_DOWNLOAD_SIZE
+	}
+
+	var payloadStats *SharedMap[payloadStatsKey, InteractionPayloadStats]
+	if opt.TrackPayloadSizes {
+		payloadStats = NewSharedMap[payloadStatsKey, InteractionPayloadStats]()
+	}
+
+	rest := NewRest(opt.Token)
+	if opt.TokenProvider != nil {
+		rest = NewRestWithTokenProvider(BOT_AUTH_MODE, opt.TokenProvider)
+	}
+	if opt.HTTPClient != nil {
+		rest.HTTPClient = *opt.HTTPClient
+	}
+	if opt.Transport != nil {
+		rest.HTTPClient.Transport = opt.Transport
+	}
+	rest.ConditionalStore = opt.ConditionalStore
+	rest.CoalesceRequests = opt.CoalesceRequests
+	rest.RequestTimeout = opt.RequestTimeout
+	rest.UploadTimeout = opt.UploadTimeout
+	rest.BaseURL = opt.APIURL
+	rest.APIVersion = opt.APIVersion
+	rest.ProxyMode = opt.RestProxy
+	rest.Logger = opt.Logger
+	rest.Metrics = metrics
+	rest.UnauthorizedHandler = opt.UnauthorizedHandler
+	rest.OnAPIDegraded = opt.OnAPIDegraded
+
 	return Client{
-		ApplicationID:       botUserID,
-		PublicKey:           discordPublicKey,
-		Rest:                NewRest(opt.Token),
-		commands:            NewSharedMap[string, Command](),
-		commandContexts:     contexts,
-		staticComponents:    NewSharedMap[string, func(ComponentInteraction)](),
-		staticModals:        NewSharedMap[string, func(ModalInteraction)](),
-		preCommandHandler:   opt.PreCommandHook,
-		postCommandHandler:  opt.PostCommandHook,
-		errorCommandHandler: opt.ErrorCommandHandler,
-		componentHandler:    opt.ComponentHandler,
-		modalHandler:        opt.ModalHandler,
-		queuedComponents:    NewSharedMap[string, chan *ComponentInteraction](),
-		queuedModals:        NewSharedMap[string, chan *ModalInteraction](),
+		ApplicationID:           botUserID,
+		PublicKey:               discordPublicKey,
+		Rest:                    rest,
+		Cache:                   opt.Cache,
+		Names:                   opt.NameCache,
+		Scheduler:               NewTaskScheduler(logger),
+		commands:                NewSharedMap[string, Command](),
+		commandContexts:         contexts,
+		integrationTypes:        opt.DefaultIntegrationTypes,
+		staticComponents:        NewSharedMap[string, func(ComponentInteraction)](),
+		staticModals:            NewSharedMap[string, func(ModalInteraction)](),
+		commandMiddlewares:      opt.CommandMiddlewares,
+		preCommandHandler:       opt.PreCommandHook,
+		postCommandHandler:      opt.PostCommandHook,
+		errorCommandHandler:     opt.ErrorCommandHandler,
+		componentHandler:        opt.ComponentHandler,
+		modalHandler:            opt.ModalHandler,
+		panicHandler:            opt.OnPanic,
+		panicMessage:            opt.PanicMessage,
+		queuedComponents:        NewSharedMap[string, chan *ComponentInteraction](),
+		queuedModals:            NewSharedMap[string, chan *ModalInteraction](),
+		messageCollectors:       NewSharedMap[Snowflake, chan *ComponentInteraction](),
+		disabledCommands:        NewSharedMap[string, struct{}](),
+		disabledCommandsStore:   opt.DisabledCommandsStore,
+		dedupStore:              opt.DedupStore,
+		disabledCommandResponse: disabledCommandResponse,
+		useJSONNumber:           opt.UseJSONNumber,
+		reportUnknown:           opt.ReportUnknownFields,
+		logger:                  logger,
+		metrics:                 metrics,
+		payloadStats:            payloadStats,
+		translator:              opt.Translator,
+		interactionTimeout:      interactionTimeout,
+		cancelOnDisconnect:      opt.CancelOnDisconnect,
+		events:                  newEventDispatcher(opt.EventWorkers, opt.EventQueueSize, opt.EventOverflow),
+		publisher:               opt.EventPublisher,
+		publishOnly:             opt.EventPublisher != nil && opt.PublishOnly,
+		publishInteractions:     opt.EventPublisher != nil && opt.PublishInteractions,
+		resources:               opt.ResourceRegistry,
+		lifecycle:               newLifecycle(),
+		modules:                 &moduleRegistry{},
+		trustedProxies:          trustedProxies,
+		realIPHeader:            http.CanonicalHeaderKey(opt.RealIPHeader),
+		allowedSources:          allowedSources,
+		maxDownloadSize:         maxDownloadSize,
 	}
 }
 
@@ -170,6 +358,59 @@ func (client *Client) AwaitModal(customIDs []string) (<-chan *ModalInteraction,
 	return signalChan, cleanup, nil
 }
 
+// Checks whether bot token is valid by fetching bot's own user account.
+// Call it once on startup to fail early with clear error instead of on first interaction.
+//
+// https://discord.com/developers/docs/resources/user#get-current-user
+func (client *Client) ValidateToken() (User, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/users/@me", nil)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return User{}, ErrInvalidToken
+		}
+		return User{}, fmt.Errorf("failed to validate bot token: %w", err)
+	}
+
+	res := User{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return User{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Returned by Client.TestConnection when ClientOptions.PublicKey doesn't belong to application of bot token.
+var ErrPublicKeyMismatch = errors.New("public key doesn't match application of bot token - interaction signatures would never verify")
+
+// Checks whether client is configured correctly: bot token is valid (returns ErrInvalidToken otherwise) and public key belongs
+// to the same application (returns ErrPublicKeyMismatch otherwise). On success it fills Client.User & Client.ApplicationFlags.
+// Call it once on startup, before serving interactions, to fail early with clear error instead of opaque 401 responses later.
+//
+// https://discord.com/developers/docs/resources/application#get-current-application
+func (client *Client) TestConnection() error {
+	user, err := client.ValidateToken()
+	if err != nil {
+		return err
+	}
+
+	app, err := client.FetchApplication()
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return ErrInvalidToken
+		}
+		return fmt.Errorf("failed to fetch application: %w", err)
+	}
+
+	if app.VerifyKey != "" && app.VerifyKey != hex.EncodeToString(client.PublicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	client.User = user
+	client.ApplicationFlags = app.Flags
+	return nil
+}
+
 // Pings Discord API and returns time it took to get response.
 func (client *Client) Ping() time.Duration {
 	start := time.Now()
@@ -178,13 +419,18 @@ func (client *Client) Ping() time.Duration {
 }
 
 func (client *Client) SendMessage(channelID Snowflake, message Message, files []File) (Message, error) {
-	raw, err := client.Rest.RequestWithFiles(http.MethodPost, "/channels/"+channelID.String()+"/messages", message, files)
+	return client.SendMessageWithContext(context.Background(), channelID, message, files)
+}
+
+// Works like Client.SendMessage but with context - use it with WithResultInfo to read rate limit headroom.
+func (client *Client) SendMessageWithContext(ctx context.Context, channelID Snowflake, message Message, files []File) (Message, error) {
+	raw, err := client.Rest.RequestWithFilesContext(ctx, http.MethodPost, "/channels/"+channelID.String()+"/messages", message, files)
 	if err != nil {
 		return Message{}, err
 	}
 
 	res := Message{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return Message{}, errors.New("failed to parse received data from discord")
 	}
@@ -199,24 +445,23 @@ func (client *Client) SendLinearMessage(channelID Snowflake, content string) (Me
 // Creates (or fetches if already exists) user's private text channel (DM) and tries to send message into it.
 // Warning! Discord's user channels endpoint has huge rate limits so please reuse Message#ChannelID whenever possible.
 func (client *Client) SendPrivateMessage(userID Snowflake, content Message, files []File) (Message, error) {
-	res := make(map[string]interface{}, 0)
-	res["recipient_id"] = userID
-
-	raw, err := client.Rest.Request(http.MethodPost, "/users/@me/channels", res)
+	raw, err := client.Rest.Request(http.MethodPost, "/users/@me/channels", struct {
+		RecipientID Snowflake `json:"recipient_id"`
+	}{userID})
 	if err != nil {
 		return Message{}, err
 	}
 
-	err = json.Unmarshal(raw, &res)
-	if err != nil {
-		return Message{}, errors.New("failed to parse received data from discord")
+	var channel struct {
+		ID Snowflake `json:"id"`
 	}
 
-	channelID, err := StringToSnowflake(res["id"].(string))
+	err = unmarshalJSON(raw, &channel)
 	if err != nil {
-		return Message{}, err
+		return Message{}, errors.New("failed to parse received data from discord")
 	}
 
+	channelID := channel.ID
 	msg, err := client.SendMessage(channelID, content, files)
 	msg.ChannelID = channelID // Just in case.
 
@@ -238,33 +483,265 @@ func (client *Client) CrosspostMessage(channelID Snowflake, messageID Snowflake)
 	return err
 }
 
+// Follows announcement channel, so its crossposted messages are sent to target channel (through webhook created by Discord).
+// Requires MANAGE_WEBHOOKS permission in target channel. Returns followed channel's ID & ID of created webhook - delete webhook to unfollow.
+//
+// https://discord.com/developers/docs/resources/channel#follow-announcement-channel
+func (client *Client) FollowAnnouncementChannel(channelID Snowflake, targetChannelID Snowflake) (FollowedChannel, error) {
+	raw, err := client.Rest.Request(http.MethodPost, "/channels/"+channelID.String()+"/followers", struct {
+		WebhookChannelID Snowflake `json:"webhook_channel_id"`
+	}{
+		WebhookChannelID: targetChannelID,
+	})
+	if err != nil {
+		return FollowedChannel{}, err
+	}
+
+	res := FollowedChannel{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return FollowedChannel{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
 func (client *Client) FetchUser(id Snowflake) (User, error) {
+	if client.Cache != nil {
+		if user, ok := client.Cache.User(id); ok {
+			return user, nil
+		}
+	}
+
 	raw, err := client.Rest.Request(http.MethodGet, "/users/"+id.String(), nil)
 	if err != nil {
 		return User{}, err
 	}
 
 	res := User{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return User{}, errors.New("failed to parse received data from discord")
 	}
 
+	if client.Cache != nil {
+		client.Cache.SetUser(res)
+	}
+
 	return res, nil
 }
 
 func (client *Client) FetchMember(guildID Snowflake, memberID Snowflake) (Member, error) {
+	if client.Cache != nil {
+		if member, ok := client.Cache.Member(guildID, memberID); ok {
+			return member, nil
+		}
+	}
+
 	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String()+"/members/"+memberID.String(), nil)
 	if err != nil {
 		return Member{}, err
 	}
 
 	res := Member{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return Member{}, errors.New("failed to parse received data from discord")
 	}
 
+	res.GuildID = guildID
+	if client.Cache != nil {
+		client.Cache.SetMember(guildID, res)
+	}
+
+	return res, nil
+}
+
+// Streams guild members (up to limit, max 1000) one by one instead of loading whole list into memory.
+// Use after = 0 to start from the beginning. Requires GUILD_MEMBERS privileged intent enabled in developer portal.
+//
+// https://discord.com/developers/docs/resources/guild#list-guild-members
+func (client *Client) StreamMembers(guildID Snowflake, limit uint16, after Snowflake, fn func(member Member) error) error {
+	route := "/guilds/" + guildID.String() + "/members?limit=" + strconv.FormatUint(uint64(limit), 10)
+	if after != 0 {
+		route += "&after=" + after.String()
+	}
+
+	return client.Rest.RequestStream(http.MethodGet, route, nil, func(body io.Reader) error {
+		return DecodeJSONArray(body, func(member Member) error {
+			member.GuildID = guildID
+			return fn(member)
+		})
+	})
+}
+
+// Streams channel messages (up to limit, max 100) one by one, from newest to oldest.
+// Use before = 0 to start from the latest message.
+//
+// https://discord.com/developers/docs/resources/message#get-channel-messages
+func (client *Client) StreamMessages(channelID Snowflake, limit uint8, before Snowflake, fn func(message Message) error) error {
+	route := "/channels/" + channelID.String() + "/messages?limit=" + strconv.FormatUint(uint64(limit), 10)
+	if before != 0 {
+		route += "&before=" + before.String()
+	}
+
+	return client.Rest.RequestStream(http.MethodGet, route, nil, func(body io.Reader) error {
+		return DecodeJSONArray(body, fn)
+	})
+}
+
+// https://discord.com/developers/docs/resources/guild#get-guild
+func (client *Client) FetchGuild(guildID Snowflake) (Guild, error) {
+	if client.Cache != nil {
+		if guild, ok := client.Cache.Guild(guildID); ok {
+			return guild, nil
+		}
+	}
+
+	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String(), nil)
+	if err != nil {
+		return Guild{}, err
+	}
+
+	res := Guild{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Guild{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Cache != nil {
+		client.Cache.SetGuild(res)
+	}
+
+	if client.Names != nil {
+		client.Names.SetGuildName(res.ID, res.Name)
+	}
+
+	return res, nil
+}
+
+// Requires MANAGE_GUILD permission. Returns updated guild.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild
+func (client *Client) ModifyGuild(guildID Snowflake, payload ModifyGuildPayload) (Guild, error) {
+	raw, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String(), payload)
+	if err != nil {
+		return Guild{}, err
+	}
+
+	res := Guild{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Guild{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Cache != nil {
+		client.Cache.SetGuild(res)
+	}
+
+	if client.Names != nil {
+		client.Names.SetGuildName(res.ID, res.Name)
+	}
+
+	return res, nil
+}
+
+// Shows or hides boost progress bar in guild. Requires MANAGE_GUILD permission.
+func (client *Client) SetPremiumProgressBar(guildID Snowflake, enabled bool) (Guild, error) {
+	return client.ModifyGuild(guildID, ModifyGuildPayload{PremiumProgressBarEnabled: &enabled})
+}
+
+// https://discord.com/developers/docs/resources/channel#get-channel
+func (client *Client) FetchChannel(channelID Snowflake) (Channel, error) {
+	if client.Cache != nil {
+		if channel, ok := client.Cache.Channel(channelID); ok {
+			return channel, nil
+		}
+	}
+
+	raw, err := client.Rest.Request(http.MethodGet, "/channels/"+channelID.String(), nil)
+	if err != nil {
+		return Channel{}, err
+	}
+
+	res := Channel{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Channel{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Cache != nil {
+		client.Cache.SetChannel(res)
+	}
+
+	if client.Names != nil {
+		client.Names.SetChannelName(res.ID, res.Name)
+	}
+
+	return res, nil
+}
+
+// Creates new channel in guild. Name is validated first (check ValidateChannelName) to avoid pointless request.
+//
+// https://discord.com/developers/docs/resources/guild#create-guild-channel
+func (client *Client) CreateChannel(guildID Snowflake, payload CreateChannelPayload) (Channel, error) {
+	if err := ValidateChannelName(payload.Name, payload.Type); err != nil {
+		return Channel{}, err
+	}
+
+	raw, err := client.Rest.Request(http.MethodPost, "/guilds/"+guildID.String()+"/channels", payload)
+	if err != nil {
+		return Channel{}, err
+	}
+
+	res := Channel{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Channel{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Cache != nil {
+		client.Cache.SetChannel(res)
+	}
+
+	if client.Names != nil {
+		client.Names.SetChannelName(res.ID, res.Name)
+	}
+
+	if client.resources != nil {
+		client.resources.Track(guildID, OwnedResource{Type: CHANNEL_RESOURCE_TYPE, ID: res.ID})
+	}
+
+	return res, nil
+}
+
+// Modifies guild channel. New name (if set) is only checked for length - use ValidateChannelName to check it against channel type.
+//
+// https://discord.com/developers/docs/resources/channel#modify-channel
+func (client *Client) ModifyChannel(channelID Snowflake, payload ModifyChannelPayload) (Channel, error) {
+	if payload.Name != "" && utf8.RuneCountInString(payload.Name) > MAX_CHANNEL_NAME_LENGTH {
+		return Channel{}, errors.New("channel name cannot be longer than 100 characters")
+	}
+
+	raw, err := client.Rest.Request(http.MethodPatch, "/channels/"+channelID.String(), payload)
+	if err != nil {
+		return Channel{}, err
+	}
+
+	res := Channel{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Channel{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Cache != nil {
+		client.Cache.SetChannel(res)
+	}
+
+	if client.Names != nil {
+		client.Names.SetChannelName(res.ID, res.Name)
+	}
+
 	return res, nil
 }
 
@@ -274,7 +751,7 @@ func (client *Client) FetchMember(guildID Snowflake, memberID Snowflake) (Member
 //
 // https://discord.com/developers/docs/resources/entitlement#list-entitlements
 func (client *Client) FetchEntitlementsPage(queryFilter string) ([]Entitlement, error) {
-	if queryFilter[0] != '?' {
+	if queryFilter != "" && queryFilter[0] != '?' {
 		queryFilter = "?" + queryFilter
 	}
 
@@ -284,7 +761,7 @@ func (client *Client) FetchEntitlementsPage(queryFilter string) ([]Entitlement,
 		return res, err
 	}
 
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return res, errors.New("failed to parse received data from discord")
 	}
@@ -292,6 +769,11 @@ func (client *Client) FetchEntitlementsPage(queryFilter string) ([]Entitlement,
 	return res, nil
 }
 
+// Mirror method to Client.FetchEntitlementsPage but with typed filter.
+func (client *Client) FetchEntitlements(filter EntitlementFilter) ([]Entitlement, error) {
+	return client.FetchEntitlementsPage(filter.query())
+}
+
 // https://discord.com/developers/docs/resources/entitlement#get-entitlement
 func (client *Client) FetchEntitlement(entitlementID Snowflake) (Entitlement, error) {
 	raw, err := client.Rest.Request(http.MethodGet, "/applications/"+client.ApplicationID.String()+"/entitlements/"+entitlementID.String(), nil)
@@ -300,7 +782,7 @@ func (client *Client) FetchEntitlement(entitlementID Snowflake) (Entitlement, er
 	}
 
 	res := Entitlement{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return Entitlement{}, errors.New("failed to parse received data from discord")
 	}
@@ -328,3 +810,21 @@ func (client *Client) DeleteTestEntitlement(entitlementID Snowflake) error {
 	_, err := client.Rest.Request(http.MethodDelete, "/applications/"+client.ApplicationID.String()+"/entitlements/"+entitlementID.String(), nil)
 	return err
 }
+
+// Returns all SKUs of application. Subscriptions come with SUBSCRIPTION_GROUP_SKU_TYPE SKU too - use SUBSCRIPTION_SKU_TYPE one for entitlement checks.
+//
+// https://discord.com/developers/docs/resources/sku#list-skus
+func (client *Client) FetchSkus() ([]Sku, error) {
+	res := make([]Sku, 0)
+	raw, err := client.Rest.Request(http.MethodGet, "/applications/"+client.ApplicationID.String()+"/skus", nil)
+	if err != nil {
+		return res, err
+	}
+
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return res, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package tempest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONCodec encodes & decodes JSON exchanged with Discord: incoming interactions, responses and REST payloads.
+// Replace it with SetJSONCodec to use faster library (like sonic or jsoniter), most of them expose compatible functions:
+//
+//	tempest.SetJSONCodec(tempest.JSONCodecFuncs{MarshalFunc: sonic.ConfigStd.Marshal, UnmarshalFunc: sonic.ConfigStd.Unmarshal})
+//
+// Codec has to respect standard MarshalJSON/UnmarshalJSON methods and struct tags, including "omitzero" & ",string".
+// Unmarshal must not keep references to data (copy strings & raw messages) - incoming payloads are read into reused buffers.
+type JSONCodec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// Adapts pair of functions into JSONCodec.
+type JSONCodecFuncs struct {
+	MarshalFunc   func(v any) ([]byte, error)
+	UnmarshalFunc func(data []byte, v any) error
+}
+
+func (codec JSONCodecFuncs) Marshal(v any) ([]byte, error) {
+	return codec.MarshalFunc(v)
+}
+
+func (codec JSONCodecFuncs) Unmarshal(data []byte, v any) error {
+	return codec.UnmarshalFunc(data, v)
+}
+
+// Default codec, based on encoding/json. It doesn't escape HTML characters, so mentions like "<@123>" are sent as is.
+type StdJSONCodec struct{}
+
+func (StdJSONCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (StdJSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+var codec JSONCodec = StdJSONCodec{}
+
+// Replaces codec used by all clients. Call it once at startup, before creating any client - it's not safe to call while requests are processed.
+// Helpers with specific needs (like UnmarshalWithNumbers, UnmarshalStrict or custom MarshalJSON methods) always use encoding/json.
+func SetJSONCodec(c JSONCodec) {
+	if c == nil {
+		c = StdJSONCodec{}
+	}
+	codec = c
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	return codec.Marshal(v)
+}
+
+func unmarshalJSON(data []byte, v any) error {
+	return codec.Unmarshal(data, v)
+}
+
+// Reusable buffers for incoming request bodies, so each interaction doesn't allocate (and grow) fresh one.
+// Decoded structures (options, resolved maps, members) are deliberately not pooled - handlers can keep them
+// past the request (goroutines, collectors, caches), so reusing them would corrupt live data.
+var jsonBufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// Reads whole reader into pooled buffer. Release it with releaseBuffer once its bytes are no longer used.
+func readPooled(r io.Reader) (*bytes.Buffer, error) {
+	buf := jsonBufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	_, err := buf.ReadFrom(r)
+	return buf, err
+}
+
+func releaseBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > MAX_REQUEST_BODY_SIZE*2 {
+		return // Don't keep unusually large buffers around.
+	}
+	jsonBufferPool.Put(buf)
+}
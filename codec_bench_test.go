@@ -0,0 +1,63 @@
+package tempest_test
+
+import (
+	"testing"
+
+	tempest "github.com/amatsagu/tempest"
+	"github.com/amatsagu/tempest/test"
+)
+
+func BenchmarkStdJSONCodecUnmarshalInteraction(b *testing.B) {
+	codec := tempest.StdJSONCodec{}
+	payload := test.Fixture("interaction-command")
+	b.SetBytes(int64(len(payload)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		var interaction tempest.Interaction
+		if err := codec.Unmarshal(payload, &interaction); err != nil {
+			b.Fatal(err)
+		}
+
+		var data tempest.CommandInteractionData
+		if err := codec.Unmarshal(interaction.Data, &data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStdJSONCodecMarshalResponse(b *testing.B) {
+	codec := tempest.StdJSONCodec{}
+	response := tempest.ResponseMessage{
+		Type: tempest.CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
+		Data: &tempest.ResponseMessageData{
+			Content: "Welcome <@1124031285617815613>!",
+			Embeds: []tempest.Embed{{
+				Title:       "Settings",
+				Description: "Welcome message will be sent to <#1123954717421027371>.",
+				Color:       0x5865F2,
+				Fields: []tempest.EmbedField{
+					{Name: "Role", Value: "<@&1124031285617815613>", Inline: true},
+					{Name: "Delay", Value: "30s", Inline: true},
+				},
+			}},
+			Components: []tempest.LayoutComponent{
+				tempest.ActionRowComponent{
+					Type: tempest.ACTION_ROW_COMPONENT_TYPE,
+					Components: []tempest.InteractiveComponent{
+						tempest.ButtonComponent{Type: tempest.BUTTON_COMPONENT_TYPE, Style: tempest.PRIMARY_BUTTON_STYLE, Label: "Save", CustomID: "settings-save"},
+						tempest.ButtonComponent{Type: tempest.BUTTON_COMPONENT_TYPE, Style: tempest.SECONDARY_BUTTON_STYLE, Label: "Cancel", CustomID: "settings-cancel"},
+					},
+				},
+			},
+			Flags: tempest.EPHEMERAL_MESSAGE_FLAG,
+		},
+	}
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := codec.Marshal(response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
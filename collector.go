@@ -0,0 +1,97 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Makes client collect all component interactions (from any component) attached to message with matching ID.
+// Collected components are already acknowledged, just like with Client.AwaitComponent. Components with custom IDs
+// registered as static or awaited components are not collected.
+//
+// Channel buffers up to 8 interactions - when holder doesn't keep up, further ones are dropped (and logged at warn level).
+// Channel is closed after timeout or once cleanup function is called - holder should call it once done.
+func (client *Client) NewCollector(messageID Snowflake, timeout time.Duration) (<-chan *ComponentInteraction, func()) {
+	signalChan := make(chan *ComponentInteraction, 8)
+	var once sync.Once
+
+	cleanup := func() {
+		once.Do(func() {
+			client.messageCollectors.mu.Lock()
+			if client.messageCollectors.cache[messageID] == signalChan {
+				delete(client.messageCollectors.cache, messageID)
+			}
+			client.messageCollectors.mu.Unlock()
+			close(signalChan)
+		})
+	}
+
+	client.messageCollectors.Set(messageID, signalChan)
+	if timeout > 0 {
+		time.AfterFunc(timeout, cleanup)
+	}
+
+	return signalChan, cleanup
+}
+
+// Waits for first component interaction on reply to this command that passes filter (use nil filter to accept any).
+// Component interactions that didn't pass filter are acknowledged & dropped. Reply has to be sent before calling it.
+func (itx CommandInteraction) AwaitComponent(ctx context.Context, filter func(itx *ComponentInteraction) bool) (*ComponentInteraction, error) {
+	messageID, err := itx.originalMessageID()
+	if err != nil {
+		return nil, err
+	}
+
+	return itx.Client.awaitMessageComponent(ctx, messageID, filter)
+}
+
+// Fetches ID of initial reply to this interaction.
+func (itx CommandInteraction) originalMessageID() (Snowflake, error) {
+	raw, err := itx.webhookRequest(http.MethodGet, "/messages/@original", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var reply struct {
+		ID Snowflake `json:"id"`
+	}
+
+	if err := unmarshalJSON(raw, &reply); err != nil {
+		return 0, errors.New("failed to parse received data from discord")
+	}
+
+	return reply.ID, nil
+}
+
+// Waits for next component interaction on the same message that passes filter (use nil filter to accept any).
+// Component interactions that didn't pass filter are acknowledged & dropped.
+func (itx ComponentInteraction) AwaitComponent(ctx context.Context, filter func(itx *ComponentInteraction) bool) (*ComponentInteraction, error) {
+	if itx.Message == nil {
+		return nil, errors.New("component interaction has no message attached")
+	}
+
+	return itx.Client.awaitMessageComponent(ctx, itx.Message.ID, filter)
+}
+
+func (client *Client) awaitMessageComponent(ctx context.Context, messageID Snowflake, filter func(itx *ComponentInteraction) bool) (*ComponentInteraction, error) {
+	signalChan, cleanup := client.NewCollector(messageID, 0)
+	defer cleanup()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case itx, ok := <-signalChan:
+			if !ok {
+				return nil, errors.New("collector was closed")
+			}
+
+			if filter == nil || filter(itx) {
+				return itx, nil
+			}
+		}
+	}
+}
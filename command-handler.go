@@ -11,19 +11,7 @@ func (client *Client) RegisterCommand(cmd Command) error {
 		return errors.New("client already has registered \"" + cmd.Name + "\" slash command (name already in use)")
 	}
 
-	if cmd.Type == 0 {
-		cmd.Type = CHAT_INPUT_COMMAND_TYPE
-	}
-
-	if cmd.ApplicationID == 0 {
-		cmd.ApplicationID = client.ApplicationID
-	}
-
-	if len(cmd.Contexts) == 0 {
-		cmd.Contexts = client.commandContexts
-	}
-
-	client.commands.Set(cmd.Name, cmd)
+	client.commands.Set(cmd.Name, client.withCommandDefaults(cmd))
 	return nil
 }
 
@@ -37,20 +25,29 @@ func (client *Client) RegisterSubCommand(subCommand Command, parentCommandName s
 		return errors.New("client already has registered \"" + finalName + "\" slash command (name for subcommand is already in use)")
 	}
 
-	if subCommand.Type == 0 {
-		subCommand.Type = CHAT_INPUT_COMMAND_TYPE
+	client.commands.Set(finalName, client.withCommandDefaults(subCommand))
+	return nil
+}
+
+// Fills command fields left empty with client defaults.
+func (client *Client) withCommandDefaults(cmd Command) Command {
+	if cmd.Type == 0 {
+		cmd.Type = CHAT_INPUT_COMMAND_TYPE
 	}
 
-	if subCommand.ApplicationID == 0 {
-		subCommand.ApplicationID = client.ApplicationID
+	if cmd.ApplicationID == 0 {
+		cmd.ApplicationID = client.ApplicationID
 	}
 
-	if len(subCommand.Contexts) == 0 {
-		subCommand.Contexts = client.commandContexts
+	if len(cmd.Contexts) == 0 {
+		cmd.Contexts = client.commandContexts
 	}
 
-	client.commands.Set(finalName, subCommand)
-	return nil
+	if len(cmd.IntegrationTypes) == 0 {
+		cmd.IntegrationTypes = client.integrationTypes
+	}
+
+	return cmd
 }
 
 // Bind function to all components with matching custom ids. App will automatically run bound function whenever receiving component interaction with matching custom id.
@@ -88,12 +85,129 @@ func (client *Client) RegisterModal(customID string, fn func(ModalInteraction))
 	return nil
 }
 
+// Removes command (use "name@subcommand" for subcommands) from registry, together with its subcommands. Returns false if it wasn't registered.
+// Discord still lists removed command until next Client.SyncCommandsWithDiscord - it'll fail with unknown command error meanwhile.
+func (client *Client) UnregisterCommand(cmdName string) bool {
+	client.commands.mu.Lock()
+	defer client.commands.mu.Unlock()
+
+	if _, ok := client.commands.cache[cmdName]; !ok {
+		return false
+	}
+
+	delete(client.commands.cache, cmdName)
+	if !strings.Contains(cmdName, "@") {
+		for name := range client.commands.cache {
+			if strings.HasPrefix(name, cmdName+"@") {
+				delete(client.commands.cache, name)
+			}
+		}
+	}
+
+	return true
+}
+
+// Replaces whole command registry at runtime (for example after loading plugins). Subcommands are passed with "parent@subcommand" names.
+// New commands are synced with Discord first (globally or in given guilds) and routing table is swapped only once that succeeds,
+// so interactions keep hitting old handlers until then. Already running handlers are not affected, but canary routers
+// of replaced commands are - use Client.RegisterCanaryHandler again after swap.
+// Registry stays untouched on error, but with multiple guilds some of them may have been already updated on Discord side.
+func (client *Client) ReplaceCommands(commands []Command, guildIDs []Snowflake) error {
+	staging := NewSharedMap[string, Command]()
+
+	for _, cmd := range commands {
+		if strings.Contains(cmd.Name, "@") {
+			continue
+		}
+
+		if staging.Has(cmd.Name) {
+			return errors.New("commands contain \"" + cmd.Name + "\" slash command more than once")
+		}
+		staging.Set(cmd.Name, client.withCommandDefaults(cmd))
+	}
+
+	for _, cmd := range commands {
+		parent, name, ok := strings.Cut(cmd.Name, "@")
+		if !ok {
+			continue
+		}
+
+		if !staging.Has(parent) {
+			return errors.New("missing \"" + parent + "\" slash command (parent command of \"" + cmd.Name + "\" needs to be replaced together with it)")
+		}
+
+		if staging.Has(cmd.Name) {
+			return errors.New("commands contain \"" + cmd.Name + "\" subcommand more than once")
+		}
+
+		finalName := cmd.Name
+		cmd.Name = name
+		staging.Set(finalName, client.withCommandDefaults(cmd))
+	}
+
+	if err := client.syncCommands(staging, guildIDs, nil, false); err != nil {
+		return err
+	}
+
+	client.commands.mu.Lock()
+	client.commands.cache = staging.cache
+	client.commands.mu.Unlock()
+	return nil
+}
+
 func (client *Client) FindCommand(cmdName string) (Command, bool) {
 	return client.commands.Get(cmdName)
 }
 
+// Kill switch for broken commands - disabled command replies with ClientOptions.DisabledCommandMessage instead of running its handler.
+// Disabling parent command covers all of its subcommands, use "name@subcommand" to disable single one.
+// If ClientOptions.DisabledCommandsStore is set, it's disabled on all replicas.
+func (client *Client) DisableCommand(cmdName string) {
+	if client.disabledCommandsStore != nil {
+		client.disabledCommandsStore.Set(DISABLED_COMMAND_KEY_PREFIX+cmdName, []byte{'1'}, 0)
+		return
+	}
+
+	client.disabledCommands.Set(cmdName, struct{}{})
+}
+
+// Enables back command disabled with Client.DisableCommand.
+func (client *Client) EnableCommand(cmdName string) {
+	if client.disabledCommandsStore != nil {
+		client.disabledCommandsStore.Delete(DISABLED_COMMAND_KEY_PREFIX + cmdName)
+		return
+	}
+
+	client.disabledCommands.Delete(cmdName)
+}
+
+// Whether command is disabled - subcommand (like "name@subcommand") is also disabled together with its parent.
+// With ClientOptions.DisabledCommandsStore, store is the only source of truth, so command enabled by any replica
+// (or by deleting its key) is enabled everywhere.
+func (client *Client) IsCommandDisabled(cmdName string) bool {
+	if parent, _, ok := strings.Cut(cmdName, "@"); ok && client.isDisabled(parent) {
+		return true
+	}
+
+	return client.isDisabled(cmdName)
+}
+
+func (client *Client) isDisabled(cmdName string) bool {
+	if client.disabledCommandsStore != nil {
+		_, disabled := client.disabledCommandsStore.Get(DISABLED_COMMAND_KEY_PREFIX + cmdName)
+		return disabled
+	}
+
+	return client.disabledCommands.Has(cmdName)
+}
+
 func (client *Client) SyncCommandsWithDiscord(guildIDs []Snowflake, whitelist []string, reverseMode bool) error {
-	commands := parseCommandsForDiscordAPI(client.commands, whitelist, reverseMode)
+	return client.syncCommands(client.commands, guildIDs, whitelist, reverseMode)
+}
+
+// Syncs given registry (not necessarily client's one) with Discord.
+func (client *Client) syncCommands(registry *SharedMap[string, Command], guildIDs []Snowflake, whitelist []string, reverseMode bool) error {
+	commands := parseCommandsForDiscordAPI(registry, whitelist, reverseMode)
 
 	if len(guildIDs) == 0 {
 		_, err := client.Rest.Request(http.MethodPut, "/applications/"+client.ApplicationID.String()+"/commands", commands)
@@ -0,0 +1,118 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+)
+
+const MAX_COMMAND_PERMISSIONS = 100
+
+// https://discord.com/developers/docs/interactions/application-commands#application-command-permissions-object-application-command-permission-type
+type CommandPermissionType uint8
+
+const (
+	ROLE_COMMAND_PERMISSION_TYPE CommandPermissionType = iota + 1
+	USER_COMMAND_PERMISSION_TYPE
+	CHANNEL_COMMAND_PERMISSION_TYPE
+)
+
+// Allows or denies command usage for single role, user or channel.
+//
+// https://discord.com/developers/docs/interactions/application-commands#application-command-permissions-object-application-command-permissions-structure
+type CommandPermission struct {
+	ID         Snowflake             `json:"id"` // Role, user or channel ID. Use guild ID for @everyone role and AllChannelsPermissionID for all channels.
+	Type       CommandPermissionType `json:"type"`
+	Permission bool                  `json:"permission"` // True to allow, false to disallow.
+}
+
+// Permission overwrites of single command in guild. When ID equals ApplicationID, they apply to all commands without own overwrites.
+//
+// https://discord.com/developers/docs/interactions/application-commands#application-command-permissions-object-guild-application-command-permissions-structure
+type GuildCommandPermissions struct {
+	ID            Snowflake           `json:"id"` // Command ID or application ID (app-wide overwrites).
+	ApplicationID Snowflake           `json:"application_id"`
+	GuildID       Snowflake           `json:"guild_id"`
+	Permissions   []CommandPermission `json:"permissions"`
+}
+
+// Returns constant ID representing all channels of guild in command permissions (guild ID - 1).
+func AllChannelsPermissionID(guildID Snowflake) Snowflake {
+	return guildID - 1
+}
+
+// Returns permission overwrites of all commands in guild (only commands that have any).
+//
+// https://discord.com/developers/docs/interactions/application-commands#get-guild-application-command-permissions
+func (client *Client) FetchAllCommandPermissions(guildID Snowflake) ([]GuildCommandPermissions, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/applications/"+client.ApplicationID.String()+"/guilds/"+guildID.String()+"/commands/permissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]GuildCommandPermissions, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Returns permission overwrites of single command in guild. Use application ID as commandID to get app-wide overwrites.
+//
+// https://discord.com/developers/docs/interactions/application-commands#get-application-command-permissions
+func (client *Client) FetchCommandPermissions(guildID Snowflake, commandID Snowflake) (GuildCommandPermissions, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/applications/"+client.ApplicationID.String()+"/guilds/"+guildID.String()+"/commands/"+commandID.String()+"/permissions", nil)
+	if err != nil {
+		return GuildCommandPermissions{}, err
+	}
+
+	res := GuildCommandPermissions{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return GuildCommandPermissions{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Replaces permission overwrites of single command in guild (max 100). Use application ID as commandID to edit app-wide overwrites.
+//
+// Discord doesn't allow bot token here - it requires OAuth2 access token (with "applications.commands.permissions.update" scope)
+// of user who can manage guild & roles, so pass one received through oauth2 package.
+//
+// https://discord.com/developers/docs/interactions/application-commands#edit-application-command-permissions
+func (client *Client) EditCommandPermissions(accessToken string, guildID Snowflake, commandID Snowflake, permissions []CommandPermission) (GuildCommandPermissions, error) {
+	if len(permissions) > MAX_COMMAND_PERMISSIONS {
+		return GuildCommandPermissions{}, errors.New("command can have at most 100 permission overwrites")
+	}
+
+	if permissions == nil {
+		permissions = make([]CommandPermission, 0)
+	}
+
+	rest := NewBearerRest(accessToken)
+	rest.HTTPClient = client.Rest.HTTPClient
+	rest.BaseURL = client.Rest.BaseURL
+	rest.APIVersion = client.Rest.APIVersion
+	rest.ProxyMode = client.Rest.ProxyMode
+	rest.Logger = client.Rest.Logger
+	rest.Metrics = client.Rest.Metrics
+
+	raw, err := rest.Request(http.MethodPut, "/applications/"+client.ApplicationID.String()+"/guilds/"+guildID.String()+"/commands/"+commandID.String()+"/permissions", struct {
+		Permissions []CommandPermission `json:"permissions"`
+	}{
+		Permissions: permissions,
+	})
+	if err != nil {
+		return GuildCommandPermissions{}, err
+	}
+
+	res := GuildCommandPermissions{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return GuildCommandPermissions{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,236 @@
+package tempest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Command defined as Go struct. Exported fields tagged with `option:"name"` become command options.
+// On each use, struct is copied (so untagged fields like database handles are kept), filled with
+// received options (check CommandInteraction.BindOptions) and then its Handle method is called.
+//
+// Supported field tags:
+//   - option:"name" or option:"name,required"
+//   - description:"..." (required by Discord)
+//   - min:"1", max:"10" (value range for numbers, length range for strings)
+//   - choices:"red,green,blue" or choices:"Red:red,Green:green" (display name : value)
+//   - channel_types:"0,5" (allowed ChannelType values for channel options)
+//   - type:"user" (only for Snowflake fields: user, channel, role, mentionable or attachment - defaults to mentionable)
+//   - autocomplete:"true"
+type StructCommand interface {
+	Handle(itx *CommandInteraction) error
+}
+
+// Registers command using schema generated from struct fields. Provided command is used for
+// remaining metadata (name, description, permissions, etc.) - its Options & SlashCommandHandler are overwritten.
+func (client *Client) RegisterStructCommand(cmd Command, v StructCommand) error {
+	if err := bindStructCommand(&cmd, v); err != nil {
+		return err
+	}
+	return client.RegisterCommand(cmd)
+}
+
+// Works like Client.RegisterStructCommand but registers it as subcommand of already registered command.
+func (client *Client) RegisterStructSubCommand(subCommand Command, v StructCommand, parentCommandName string) error {
+	if err := bindStructCommand(&subCommand, v); err != nil {
+		return err
+	}
+	return client.RegisterSubCommand(subCommand, parentCommandName)
+}
+
+// Generates command options from struct tags. Check StructCommand for list of supported tags.
+func CommandOptionsFromStruct(v any) ([]CommandOption, error) {
+	structType := reflect.TypeOf(v)
+	if structType != nil && structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return nil, errors.New("CommandOptionsFromStruct expects struct or pointer to struct")
+	}
+
+	options := make([]CommandOption, 0, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag, ok := field.Tag.Lookup("option")
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		option := CommandOption{
+			Name:         name,
+			Description:  field.Tag.Get("description"),
+			Required:     flags == "required",
+			AutoComplete: field.Tag.Get("autocomplete") == "true",
+		}
+
+		if option.Description == "" {
+			return nil, fmt.Errorf("option %q is missing description tag", name)
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		optionType, err := structOptionType(fieldType, field.Tag.Get("type"))
+		if err != nil {
+			return nil, fmt.Errorf("option %q: %w", name, err)
+		}
+		option.Type = optionType
+
+		if err := applyStructOptionLimits(&option, field.Tag); err != nil {
+			return nil, fmt.Errorf("option %q: %w", name, err)
+		}
+
+		options = append(options, option)
+	}
+
+	// Discord requires all required options to be placed before optional ones.
+	slices.SortStableFunc(options, func(a, b CommandOption) int {
+		switch {
+		case a.Required == b.Required:
+			return 0
+		case a.Required:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return options, nil
+}
+
+func bindStructCommand(cmd *Command, v StructCommand) error {
+	options, err := CommandOptionsFromStruct(v)
+	if err != nil {
+		return fmt.Errorf("failed to generate options for \"%s\" command: %w", cmd.Name, err)
+	}
+
+	template := reflect.ValueOf(v)
+	if template.Kind() == reflect.Pointer {
+		template = template.Elem()
+	}
+
+	cmd.Options = options
+	cmd.SlashCommandHandler = func(itx *CommandInteraction) error {
+		instance := reflect.New(template.Type())
+		instance.Elem().Set(template)
+
+		if err := itx.BindOptions(instance.Interface()); err != nil {
+			return err
+		}
+
+		return instance.Interface().(StructCommand).Handle(itx)
+	}
+
+	return nil
+}
+
+func structOptionType(fieldType reflect.Type, typeTag string) (OptionType, error) {
+	switch fieldType {
+	case snowflakeType:
+		switch typeTag {
+		case "user":
+			return USER_OPTION_TYPE, nil
+		case "channel":
+			return CHANNEL_OPTION_TYPE, nil
+		case "role":
+			return ROLE_OPTION_TYPE, nil
+		case "attachment":
+			return ATTACHMENT_OPTION_TYPE, nil
+		case "", "mentionable":
+			return MENTIONABLE_OPTION_TYPE, nil
+		}
+		return 0, fmt.Errorf("unknown type tag %q", typeTag)
+	case userType, memberType:
+		return USER_OPTION_TYPE, nil
+	case roleType:
+		return ROLE_OPTION_TYPE, nil
+	case partialChannelType:
+		return CHANNEL_OPTION_TYPE, nil
+	case attachmentType:
+		return ATTACHMENT_OPTION_TYPE, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return STRING_OPTION_TYPE, nil
+	case reflect.Bool:
+		return BOOLEAN_OPTION_TYPE, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return INTEGER_OPTION_TYPE, nil
+	case reflect.Float32, reflect.Float64:
+		return NUMBER_OPTION_TYPE, nil
+	}
+
+	return 0, fmt.Errorf("unsupported field type %s", fieldType)
+}
+
+func applyStructOptionLimits(option *CommandOption, tag reflect.StructTag) error {
+	for key, target := range map[string]*float64{"min": &option.MinValue, "max": &option.MaxValue} {
+		raw := tag.Get(key)
+		if raw == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s tag: %w", key, err)
+		}
+
+		if option.Type == STRING_OPTION_TYPE {
+			if key == "min" {
+				option.MinLength = uint16(value)
+			} else {
+				option.MaxLength = uint16(value)
+			}
+			continue
+		}
+
+		*target = value
+	}
+
+	if raw := tag.Get("channel_types"); raw != "" {
+		for _, part := range strings.Split(raw, ",") {
+			value, err := strconv.ParseUint(strings.TrimSpace(part), 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid channel_types tag: %w", err)
+			}
+			option.ChannelTypes = append(option.ChannelTypes, ChannelType(value))
+		}
+	}
+
+	if raw := tag.Get("choices"); raw != "" {
+		for _, part := range strings.Split(raw, ",") {
+			name, value, found := strings.Cut(part, ":")
+			if !found {
+				value = name
+			}
+
+			choice := CommandOptionChoice{Name: strings.TrimSpace(name)}
+			switch option.Type {
+			case STRING_OPTION_TYPE:
+				choice.Value = strings.TrimSpace(value)
+			case INTEGER_OPTION_TYPE, NUMBER_OPTION_TYPE:
+				number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				if err != nil {
+					return fmt.Errorf("invalid choice value %q: %w", value, err)
+				}
+				choice.Value = number
+			default:
+				return errors.New("choices are only supported for string, integer & number options")
+			}
+
+			option.Choices = append(option.Choices, choice)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Describes single difference between locally registered command and its version stored by Discord.
+type CommandDrift struct {
+	Name   string
+	Local  *Command // Nil if command exists only on Discord side.
+	Remote *Command // Nil if command is missing on Discord side.
+	Fields []string // Names of fields that are different, empty if command is missing on either side.
+}
+
+func (drift CommandDrift) String() string {
+	switch {
+	case drift.Remote == nil:
+		return "\"" + drift.Name + "\" is missing on discord"
+	case drift.Local == nil:
+		return "\"" + drift.Name + "\" exists only on discord"
+	default:
+		return "\"" + drift.Name + "\" differs in: " + strings.Join(drift.Fields, ", ")
+	}
+}
+
+type CommandDriftWatcherOptions struct {
+	Interval  time.Duration                                  // How often to check commands, defaults to 1 hour.
+	GuildIDs  []Snowflake                                    // Guilds to check, leave empty to check global commands.
+	AutoApply bool                                           // Whether to re-apply local definitions once drift is detected.
+	OnDrift   func(guildID Snowflake, drifts []CommandDrift) // Optional function called with each detected drift.
+	Leader    *LeaderElector                                 // Optional leader elector - when set, checks only run on leader instance.
+}
+
+// Fetches all commands of your app stored by Discord. Use guildID = 0 to fetch global commands.
+//
+// https://discord.com/developers/docs/interactions/application-commands#get-global-application-commands
+func (client *Client) FetchCommands(guildID Snowflake) ([]Command, error) {
+	route := "/applications/" + client.ApplicationID.String() + "/commands"
+	if guildID != 0 {
+		route = "/applications/" + client.ApplicationID.String() + "/guilds/" + guildID.String() + "/commands"
+	}
+
+	raw, err := client.Rest.Request(http.MethodGet, route+"?with_localizations=true", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Command, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Compares locally registered commands with ones stored by Discord (for example edited by another tool or a different deployment).
+// Use guildID = 0 to compare with global commands.
+func (client *Client) DetectCommandDrift(guildID Snowflake) ([]CommandDrift, error) {
+	remote, err := client.FetchCommands(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	local := parseCommandsForDiscordAPI(client.commands, nil, false)
+	remoteByName := make(map[string]Command, len(remote))
+	for _, cmd := range remote {
+		remoteByName[cmd.Name] = cmd
+	}
+
+	drifts := make([]CommandDrift, 0)
+	for _, localCmd := range local {
+		remoteCmd, ok := remoteByName[localCmd.Name]
+		if !ok {
+			drifts = append(drifts, CommandDrift{Name: localCmd.Name, Local: &localCmd})
+			continue
+		}
+
+		delete(remoteByName, localCmd.Name)
+		if fields := diffCommands(localCmd, remoteCmd); len(fields) > 0 {
+			drifts = append(drifts, CommandDrift{Name: localCmd.Name, Local: &localCmd, Remote: &remoteCmd, Fields: fields})
+		}
+	}
+
+	for name, remoteCmd := range remoteByName {
+		drifts = append(drifts, CommandDrift{Name: name, Remote: &remoteCmd})
+	}
+
+	return drifts, nil
+}
+
+// Periodically checks whether commands stored by Discord match locally registered ones, logs every detected drift
+// and optionally re-applies local definitions. It blocks until context is cancelled so run it in a separate goroutine.
+func (client *Client) WatchCommandDrift(ctx context.Context, opt CommandDriftWatcherOptions) {
+	if opt.Interval == 0 {
+		opt.Interval = time.Hour
+	}
+
+	guildIDs := opt.GuildIDs
+	if len(guildIDs) == 0 {
+		guildIDs = []Snowflake{0}
+	}
+
+	ticker := time.NewTicker(opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if opt.Leader != nil && !opt.Leader.IsLeader() {
+			continue
+		}
+
+		for _, guildID := range guildIDs {
+			drifts, err := client.DetectCommandDrift(guildID)
+			if err != nil {
+				client.logger.Error("failed to detect command drift", "guild_id", guildID, "error", err)
+				continue
+			}
+
+			if len(drifts) == 0 {
+				continue
+			}
+
+			for _, drift := range drifts {
+				client.logger.Warn("detected command drift", "guild_id", guildID, "drift", drift.String())
+			}
+
+			if opt.OnDrift != nil {
+				opt.OnDrift(guildID, drifts)
+			}
+
+			if opt.AutoApply {
+				var targets []Snowflake
+				if guildID != 0 {
+					targets = []Snowflake{guildID}
+				}
+
+				if err := client.SyncCommandsWithDiscord(targets, nil, false); err != nil {
+					client.logger.Error("failed to re-apply local commands", "guild_id", guildID, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// Returns names of fields that differ between local & remote command.
+// Contexts & integration types are skipped when not set locally as Discord fills them with defaults.
+func diffCommands(local Command, remote Command) []string {
+	fields := make([]string, 0)
+	compare := func(name string, a any, b any) {
+		rawA, _ := marshalJSON(a)
+		rawB, _ := marshalJSON(b)
+		if string(rawA) != string(rawB) {
+			fields = append(fields, name)
+		}
+	}
+
+	compare("type", local.Type, remote.Type)
+	compare("description", local.Description, remote.Description)
+	compare("name_localizations", local.NameLocalizations, remote.NameLocalizations)
+	compare("description_localizations", local.DescriptionLocalizations, remote.DescriptionLocalizations)
+	compare("options", sortedCommandOptions(local.Options), sortedCommandOptions(remote.Options))
+	compare("default_member_permissions", local.RequiredPermissions, remote.RequiredPermissions)
+	compare("nsfw", local.NSFW, remote.NSFW)
+
+	if len(local.Contexts) != 0 {
+		compare("contexts", local.Contexts, remote.Contexts)
+	}
+
+	if len(local.IntegrationTypes) != 0 {
+		compare("integration_types", local.IntegrationTypes, remote.IntegrationTypes)
+	}
+
+	return fields
+}
+
+// Subcommands are built from map so their order is random - sort them to make comparison stable.
+func sortedCommandOptions(options []CommandOption) []CommandOption {
+	if len(options) == 0 || options[0].Type != SUB_OPTION_TYPE {
+		return options
+	}
+
+	res := slices.Clone(options)
+	slices.SortFunc(res, func(a, b CommandOption) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
@@ -28,7 +28,7 @@ const (
 )
 
 // https://discord.com/developers/docs/resources/application#application-object-application-integration-types
-type ApplicationIntegrationType uint8
+type ApplicationIntegrationType uint16 // use uint16 instead uint8 to avoid Go's json marshal logic that thinks of it as symbols.
 
 const (
 	GUILD_INSTALL ApplicationIntegrationType = iota
@@ -92,9 +92,10 @@ type Command struct {
 	Options                  []CommandOption              `json:"options,omitzero"`
 	RequiredPermissions      PermissionFlags              `json:"default_member_permissions,string,omitempty"` // Set of permissions represented as a bit set that are required from user/member to use command. Set it to 0 to make command unavailable for regular members (guild administrators still can use it).
 	IntegrationTypes         []ApplicationIntegrationType `json:"integration_types,omitzero"`
-	Contexts                 []InteractionContextType     `json:"contexts,omitzero"` // Interaction context(s) where the command can be used, only for globally-scoped commands. By default, all interaction context types included for new commands.
-	NSFW                     bool                         `json:"nsfw,omitempty"`    // https://discord.com/developers/docs/interactions/application-commands#agerestricted-commands
-	Version                  Snowflake                    `json:"version,omitempty"` // Autoincrementing version identifier updated during substantial record changes.
+	DMPermission             *bool                        `json:"dm_permission,omitempty"` // Deprecated: use Contexts instead. Whether global command is available in DMs.
+	Contexts                 []InteractionContextType     `json:"contexts,omitzero"`       // Interaction context(s) where the command can be used, only for globally-scoped commands. By default, all interaction context types included for new commands.
+	NSFW                     bool                         `json:"nsfw,omitempty"`          // https://discord.com/developers/docs/interactions/application-commands#agerestricted-commands
+	Version                  Snowflake                    `json:"version,omitempty"`       // Autoincrementing version identifier updated during substantial record changes.
 	Handler                  CommandHandlerType           `json:"handler,omitempty"`
 
 	AutoCompleteHandler func(itx CommandInteraction) []CommandOptionChoice `json:"-"` // Custom handler for auto complete interactions. It's a Tempest specific field.
@@ -23,6 +23,7 @@ type LabelChildComponent interface {
 }
 
 // LayoutComponent represents message layout containers like Action Rows, Sections, Separators & Containers.
+// Text displays, media galleries & files are also accepted as top-level components of messages using IS_COMPONENTS_V2_MESSAGE_FLAG.
 //
 // These are used to control final look of your custom message/embed.
 type LayoutComponent interface {
@@ -79,6 +80,7 @@ func (cmp SectionComponent) _lcmp()               {}
 
 func (cmp TextDisplayComponent) _kind() ComponentType { return cmp.Type }
 func (cmp TextDisplayComponent) _ccmp()               {}
+func (cmp TextDisplayComponent) _lcmp()               {}
 
 func (cmp ThumbnailComponent) _kind() ComponentType { return cmp.Type }
 func (cmp ThumbnailComponent) _ccmp()               {}
@@ -86,9 +88,11 @@ func (cmp ThumbnailComponent) _acmp()               {}
 
 func (cmp MediaGalleryComponent) _kind() ComponentType { return cmp.Type }
 func (cmp MediaGalleryComponent) _ccmp()               {}
+func (cmp MediaGalleryComponent) _lcmp()               {}
 
 func (cmp FileComponent) _kind() ComponentType { return cmp.Type }
 func (cmp FileComponent) _ccmp()               {}
+func (cmp FileComponent) _lcmp()               {}
 
 func (cmp SeparatorComponent) _kind() ComponentType { return cmp.Type }
 func (cmp SeparatorComponent) _lcmp()               {}
@@ -0,0 +1,83 @@
+package tempest
+
+// Helpers for composing messages out of layout components (Components V2). Such messages have to be sent with
+// IS_COMPONENTS_V2_MESSAGE_FLAG (see ResponseBuilder.ComponentsV2) - content & embeds can't be used then, use text displays instead.
+//
+// https://discord.com/developers/docs/components/overview
+
+// Returns text display with given markdown content.
+func NewTextDisplay(content string) TextDisplayComponent {
+	return TextDisplayComponent{
+		Type:    TEXT_DISPLAY_COMPONENT_TYPE,
+		Content: content,
+	}
+}
+
+// Returns section made of 1 to 3 text displays with accessory (button or thumbnail) shown next to them.
+func NewSection(accessory AccessoryComponent, texts ...string) SectionComponent {
+	components := make([]TextDisplayComponent, len(texts))
+	for i, text := range texts {
+		components[i] = NewTextDisplay(text)
+	}
+
+	return SectionComponent{
+		Type:       SECTION_COMPONENT_TYPE,
+		Components: components,
+		Accessory:  accessory,
+	}
+}
+
+// Returns thumbnail of given media - either URL or "attachment://<file name>" reference.
+func NewThumbnail(url string, description string) ThumbnailComponent {
+	return ThumbnailComponent{
+		Type:        THUMBNAIL_COMPONENT_TYPE,
+		Media:       UnfurledMediaItem{URL: url},
+		Description: description,
+	}
+}
+
+// Returns media gallery with 1 to 10 items - either URLs or "attachment://<file name>" references.
+func NewMediaGallery(urls ...string) MediaGalleryComponent {
+	items := make([]MediaGalleryItem, len(urls))
+	for i, url := range urls {
+		items[i] = MediaGalleryItem{Media: UnfurledMediaItem{URL: url}}
+	}
+
+	return MediaGalleryComponent{
+		Type:  MEDIA_GALLERY_COMPONENT_TYPE,
+		Items: items,
+	}
+}
+
+// Returns file component displaying one of files attached to message.
+func NewFileComponent(fileName string) FileComponent {
+	return FileComponent{
+		Type: FILE_COMPONENT_TYPE,
+		File: UnfurledMediaItem{URL: "attachment://" + fileName},
+	}
+}
+
+// Returns separator adding vertical padding (and optionally visible line) between components.
+func NewSeparator(divider bool, spacing SeparatorSpacing) SeparatorComponent {
+	return SeparatorComponent{
+		Type:    SEPARATOR_COMPONENT_TYPE,
+		Divider: divider,
+		Spacing: spacing,
+	}
+}
+
+// Returns container visually grouping components, similar to embed. Use AccentColor field to color its left border.
+// Components can be action rows, text displays, sections, media galleries, separators or files.
+func NewContainer(components ...AnyComponent) ContainerComponent {
+	return ContainerComponent{
+		Type:       CONTAINER_COMPONENT_TYPE,
+		Components: components,
+	}
+}
+
+// Appends layout components to response and marks it with IS_COMPONENTS_V2_MESSAGE_FLAG.
+// Content & embeds are ignored by Discord in such messages, so don't mix them with this method.
+func (builder *ResponseBuilder) ComponentsV2(components ...LayoutComponent) *ResponseBuilder {
+	builder.data.Components = append(builder.data.Components, components...)
+	return builder.Flags(IS_COMPONENTS_V2_MESSAGE_FLAG)
+}
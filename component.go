@@ -44,6 +44,14 @@ const (
 	PARAGRAPH_TEXT_INPUT_STYLE                           // A multi-line input.
 )
 
+// https://discord.com/developers/docs/components/reference#separator-separator-structure
+type SeparatorSpacing uint8
+
+const (
+	SMALL_SEPARATOR_SPACING SeparatorSpacing = iota + 1
+	LARGE_SEPARATOR_SPACING
+)
+
 // https://discord.com/developers/docs/components/reference#user-select-select-default-value-structure
 type DefaultValueType string
 
@@ -213,10 +221,10 @@ type FileComponent struct {
 
 // https://discord.com/developers/docs/components/reference#separator-separator-structure
 type SeparatorComponent struct {
-	Type    ComponentType `json:"type"` // Always = SEPARATOR_COMPONENT_TYPE (14)
-	ID      uint32        `json:"id,omitempty"`
-	Divider bool          `json:"divider"`           // Whether a visual divider should be displayed in the component (defaults to true).
-	Spacing uint8         `json:"spacing,omitempty"` // Size of separator padding—1 for small padding, 2 for large padding (defaults to 1).
+	Type    ComponentType    `json:"type"` // Always = SEPARATOR_COMPONENT_TYPE (14)
+	ID      uint32           `json:"id,omitempty"`
+	Divider bool             `json:"divider"`           // Whether a visual divider should be displayed in the component (defaults to true).
+	Spacing SeparatorSpacing `json:"spacing,omitempty"` // Size of separator padding (defaults to small).
 }
 
 // https://discord.com/developers/docs/components/reference#container-container-structure
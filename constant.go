@@ -2,11 +2,15 @@ package tempest
 
 import (
 	"fmt"
+	"log/slog"
 )
 
 const (
 	DISCORD_API_URL                    = "https://discord.com/api/v10"
+	DISCORD_API_BASE_URL               = "https://discord.com/api" // Default Rest.BaseURL.
+	DISCORD_API_VERSION                = 10                        // Default Rest.APIVersion.
 	DISCORD_CDN_URL                    = "https://cdn.discordapp.com"
+	DISCORD_MEDIA_URL                  = "https://media.discordapp.net"
 	DISCORD_EPOCH                      = 1420070400000 // Discord epoch in milliseconds
 	USER_AGENT                         = "DiscordApp https://github.com/amatsagu/tempest"
 	CONTENT_TYPE_JSON                  = "application/json"
@@ -14,6 +18,8 @@ const (
 	CONTENT_MULTIPART_JSON_DESCRIPTION = `form-data; name="payload_json"`
 	MAX_REQUEST_BODY_SIZE              = 1024 * 1024 // 1024 KB
 	ROOT_PLACEHOLDER                   = "-"
+	DISABLED_COMMAND_KEY_PREFIX        = "disabled-command:"
+	OWNED_RESOURCES_KEY_PREFIX         = "owned-resources:"
 )
 
 // Prepare those replies as they never change so there's no point in re-creating them each time.
@@ -22,3 +28,5 @@ var (
 	bodyAcknowledgeResponse    = fmt.Appendf(nil, `{"type":%d}`, DEFERRED_UPDATE_MESSAGE_RESPONSE_TYPE)
 	bodyUnknownCommandResponse = fmt.Appendf(nil, `{"type":%d,"data":{"content":"Oh uh.. It looks like you tried to use outdated/unknown slash command. Please report this bug to bot owner.","flags":%d}}`, CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE, EPHEMERAL_MESSAGE_FLAG)
 )
+
+var discardLogger = slog.New(slog.DiscardHandler)
@@ -0,0 +1,97 @@
+package tempest
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const MAX_MESSAGE_CONTENT_LENGTH = 2000
+
+// Splits long text into chunks of up to limit characters (use 0 for MAX_MESSAGE_CONTENT_LENGTH).
+// It splits on line boundaries whenever possible and keeps code blocks intact - if chunk ends inside
+// code block, block is closed and reopened (with the same language) in next chunk.
+func SplitContent(content string, limit int) []string {
+	if limit <= 0 {
+		limit = MAX_MESSAGE_CONTENT_LENGTH
+	}
+
+	if utf8.RuneCountInString(content) <= limit {
+		return []string{content}
+	}
+
+	var chunks []string
+	var current strings.Builder
+	currentLength := 0
+	fence := "" // Header of currently open code block, like "```go".
+
+	flush := func() {
+		text := strings.TrimRight(current.String(), "\n")
+		if fence != "" {
+			text += "\n```"
+		}
+
+		if strings.TrimSpace(text) != "" && text != fence+"\n```" {
+			chunks = append(chunks, text)
+		}
+
+		current.Reset()
+		currentLength = 0
+		if fence != "" {
+			current.WriteString(fence + "\n")
+			currentLength = utf8.RuneCountInString(fence) + 1
+		}
+	}
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		// Room needed to close code block & reopen it in next chunk.
+		reserve := 0
+		if fence != "" {
+			reserve = 4
+		}
+
+		for _, piece := range splitRunes(line, limit-reserve-utf8.RuneCountInString(fence)-1) {
+			pieceLength := utf8.RuneCountInString(piece)
+			if currentLength+pieceLength+reserve > limit && currentLength > 0 {
+				flush()
+			}
+
+			current.WriteString(piece)
+			currentLength += pieceLength
+		}
+
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") {
+			if fence == "" && !(len(trimmed) > 3 && strings.HasSuffix(trimmed, "```")) {
+				fence = trimmed
+			} else {
+				fence = ""
+			}
+		}
+	}
+
+	fence = ""
+	flush()
+	return chunks
+}
+
+// Sends content split into as many messages as needed (check SplitContent). If it would take more than
+// maxMessages messages, content is sent as "message.txt" file instead. Use maxMessages = 0 to always split.
+// Use ResponseBuilder.SendSplit for messages with embeds, components or allowed mentions.
+func (client *Client) SendLongMessage(channelID Snowflake, content string, maxMessages int) ([]Message, error) {
+	return NewResponse().Content(content).MaxSplitMessages(maxMessages).SendSplit(client, channelID)
+}
+
+// Splits text into pieces of up to limit runes.
+func splitRunes(text string, limit int) []string {
+	if limit <= 0 || utf8.RuneCountInString(text) <= limit {
+		return []string{text}
+	}
+
+	runes := []rune(text)
+	pieces := make([]string, 0, len(runes)/limit+1)
+	for len(runes) > limit {
+		pieces = append(pieces, string(runes[:limit]))
+		runes = runes[limit:]
+	}
+
+	return append(pieces, string(runes))
+}
@@ -0,0 +1,124 @@
+package tempest
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Function that runs before each command, in order they were added to ClientOptions.CommandMiddlewares.
+// Return type signals whether to continue command execution (return with false to stop early).
+type CommandMiddleware func(cmd Command, itx *CommandInteraction) bool
+
+// Decides who shares the same cooldown.
+type CooldownScope uint8
+
+const (
+	USER_COOLDOWN_SCOPE    CooldownScope = iota // Each user has own cooldown (default option).
+	GUILD_COOLDOWN_SCOPE                        // Whole guild shares cooldown. Falls back to channel in DMs.
+	CHANNEL_COOLDOWN_SCOPE                      // Whole channel shares cooldown.
+)
+
+type CooldownOptions struct {
+	Duration time.Duration            // Default cooldown applied to every command.
+	Scope    CooldownScope            // Who shares the same cooldown, defaults to USER_COOLDOWN_SCOPE.
+	Store    CacheStore               // Storage for active cooldowns, defaults to MemoryCacheStore. Use shared store (like RedisStore) when running multiple replicas.
+	Commands map[string]time.Duration // Optional cooldown overrides per command name (use "name@subcommand" for subcommands). Use 0 to disable cooldown for given command.
+
+	// Optional function that creates ephemeral message sent to user on cooldown.
+	// Return empty string to stay silent. Defaults to "Slow down! Try again in Xs.".
+	Message func(remaining time.Duration) string
+}
+
+// Cooldown limits how often commands can be used. Use Cooldown.Middleware as one of ClientOptions.CommandMiddlewares.
+type Cooldown struct {
+	opt CooldownOptions
+}
+
+func NewCooldown(opt CooldownOptions) *Cooldown {
+	if opt.Store == nil {
+		opt.Store = NewMemoryCacheStore()
+	}
+
+	if opt.Message == nil {
+		opt.Message = func(remaining time.Duration) string {
+			return "Slow down! Try again in " + strconv.FormatFloat(math.Ceil(remaining.Seconds()), 'f', 0, 64) + "s."
+		}
+	}
+
+	return &Cooldown{opt: opt}
+}
+
+// CommandMiddleware that stops command execution (and replies with ephemeral message) if command is still on cooldown.
+func (cooldown *Cooldown) Middleware(cmd Command, itx *CommandInteraction) bool {
+	duration := cooldown.duration(itx.Data.Name)
+	if duration <= 0 {
+		return true
+	}
+
+	key := cooldown.key(itx)
+	expiresAt := []byte(strconv.FormatInt(time.Now().Add(duration).UnixMilli(), 10))
+
+	if store, ok := cooldown.opt.Store.(AtomicCacheStore); ok {
+		if store.SetIfAbsent(key, expiresAt, duration) {
+			return true
+		}
+	} else if _, ok := cooldown.opt.Store.Get(key); !ok {
+		cooldown.opt.Store.Set(key, expiresAt, duration)
+		return true
+	}
+
+	if msg := cooldown.opt.Message(cooldown.remaining(key)); msg != "" {
+		itx.SendLinearReply(msg, true)
+	}
+
+	return false
+}
+
+// Returns how long user has to wait before using command again. Returns 0 if command is ready.
+func (cooldown *Cooldown) Remaining(itx *CommandInteraction) time.Duration {
+	return cooldown.remaining(cooldown.key(itx))
+}
+
+// Removes active cooldown for command matching interaction.
+func (cooldown *Cooldown) Reset(itx *CommandInteraction) {
+	cooldown.opt.Store.Delete(cooldown.key(itx))
+}
+
+func (cooldown *Cooldown) duration(commandName string) time.Duration {
+	if duration, ok := cooldown.opt.Commands[commandName]; ok {
+		return duration
+	}
+	return cooldown.opt.Duration
+}
+
+func (cooldown *Cooldown) remaining(key string) time.Duration {
+	raw, ok := cooldown.opt.Store.Get(key)
+	if !ok {
+		return 0
+	}
+
+	expiresAt, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return max(time.Until(time.UnixMilli(expiresAt)), 0)
+}
+
+func (cooldown *Cooldown) key(itx *CommandInteraction) string {
+	var target Snowflake
+	switch cooldown.opt.Scope {
+	case GUILD_COOLDOWN_SCOPE:
+		target = itx.GuildID
+		if target == 0 {
+			target = itx.ChannelID
+		}
+	case CHANNEL_COOLDOWN_SCOPE:
+		target = itx.ChannelID
+	default:
+		target = itx.invokerID()
+	}
+
+	return "cooldown:" + itx.Data.Name + ":" + target.String()
+}
@@ -0,0 +1,673 @@
+package discordfake
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+func (server *Server) routes() {
+	server.handle("POST /channels/{channel_id}/messages", "channel_id", true, server.createMessage)
+	server.handle("GET /channels/{channel_id}/messages", "channel_id", true, server.listMessages)
+	server.handle("GET /channels/{channel_id}/messages/{message_id}", "channel_id", true, server.getMessage)
+	server.handle("PATCH /channels/{channel_id}/messages/{message_id}", "channel_id", true, server.editMessage)
+	server.handle("DELETE /channels/{channel_id}/messages/{message_id}", "channel_id", true, server.deleteMessage)
+	server.handle("POST /channels/{channel_id}/messages/bulk-delete", "channel_id", true, server.bulkDeleteMessages)
+
+	server.handle("POST /interactions/{interaction_id}/{token}/callback", "token", false, server.interactionCallback)
+	server.handle("POST /webhooks/{application_id}/{token}", "token", false, server.createFollowup)
+	server.handle("GET /webhooks/{application_id}/{token}/messages/{message_id}", "token", false, server.getWebhookMessage)
+	server.handle("PATCH /webhooks/{application_id}/{token}/messages/{message_id}", "token", false, server.editWebhookMessage)
+	server.handle("DELETE /webhooks/{application_id}/{token}/messages/{message_id}", "token", false, server.deleteWebhookMessage)
+
+	for _, prefix := range []string{"/applications/{application_id}", "/applications/{application_id}/guilds/{guild_id}"} {
+		server.handle("GET "+prefix+"/commands", "application_id", true, server.listCommands)
+		server.handle("PUT "+prefix+"/commands", "application_id", true, server.overwriteCommands)
+		server.handle("POST "+prefix+"/commands", "application_id", true, server.createCommand)
+		server.handle("GET "+prefix+"/commands/{command_id}", "application_id", true, server.getCommand)
+		server.handle("PATCH "+prefix+"/commands/{command_id}", "application_id", true, server.editCommand)
+		server.handle("DELETE "+prefix+"/commands/{command_id}", "application_id", true, server.deleteCommand)
+	}
+
+	server.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		server.log = append(server.log, Request{Method: r.Method, Path: r.URL.Path, Status: http.StatusNotFound})
+		server.mu.Unlock()
+		writeError(w, http.StatusNotFound, 0, "404: Not Found")
+	})
+}
+
+func (server *Server) createMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	channelID, _ := tempest.StringToSnowflake(r.PathValue("channel_id"))
+	msg, err := server.readMessage(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	server.mu.Lock()
+	msg = server.newMessage(msg, channelID)
+	server.state.Messages[channelID] = append(server.state.Messages[channelID], msg)
+	server.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, msg)
+}
+
+// Returns messages newest first, like Discord does. Supports "limit", "before" & "after" query params.
+func (server *Server) listMessages(w http.ResponseWriter, r *http.Request, entry *Request) {
+	channelID, _ := tempest.StringToSnowflake(r.PathValue("channel_id"))
+	query := r.URL.Query()
+
+	limit := 50
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > 100 {
+			writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "limit must be between 1 and 100")
+			return
+		}
+		limit = parsed
+	}
+
+	before, _ := tempest.StringToSnowflake(query.Get("before"))
+	after, _ := tempest.StringToSnowflake(query.Get("after"))
+
+	server.mu.Lock()
+	var matched []tempest.Message
+	for _, msg := range server.state.Messages[channelID] {
+		if (before == 0 || msg.ID < before) && msg.ID > after {
+			matched = append(matched, msg)
+		}
+	}
+	server.mu.Unlock()
+
+	if len(matched) > limit {
+		if after != 0 && before == 0 {
+			matched = matched[:limit]
+		} else {
+			matched = matched[len(matched)-limit:]
+		}
+	}
+
+	slices.Reverse(matched)
+	writeJSON(w, http.StatusOK, append([]tempest.Message{}, matched...))
+}
+
+func (server *Server) getMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	msg, _, ok := server.findMessage(r)
+	server.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, tempest.UNKNOWN_MESSAGE_ERROR_CODE, "Unknown Message")
+		return
+	}
+	writeJSON(w, http.StatusOK, msg)
+}
+
+func (server *Server) editMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	patch, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	msg, index, ok := server.findMessage(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, tempest.UNKNOWN_MESSAGE_ERROR_CODE, "Unknown Message")
+		return
+	}
+
+	msg, err = patchMessage(msg, patch)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	server.state.Messages[msg.ChannelID][index] = msg
+	writeJSON(w, http.StatusOK, msg)
+}
+
+func (server *Server) deleteMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	msg, index, ok := server.findMessage(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, tempest.UNKNOWN_MESSAGE_ERROR_CODE, "Unknown Message")
+		return
+	}
+
+	server.state.Messages[msg.ChannelID] = slices.Delete(server.state.Messages[msg.ChannelID], index, index+1)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (server *Server) bulkDeleteMessages(w http.ResponseWriter, r *http.Request, entry *Request) {
+	channelID, _ := tempest.StringToSnowflake(r.PathValue("channel_id"))
+	raw, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	var payload struct {
+		Messages []tempest.Snowflake `json:"messages"`
+	}
+	if err := json.Unmarshal(raw, &payload); err != nil || len(payload.Messages) < 2 || len(payload.Messages) > tempest.MAX_BULK_DELETE_MESSAGES {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "messages must contain between 2 and 100 message IDs")
+		return
+	}
+
+	cutoff := tempest.SnowflakeFromTime(time.Now().Add(-tempest.MAX_BULK_DELETE_AGE))
+	for _, id := range payload.Messages {
+		if id < cutoff {
+			writeError(w, http.StatusBadRequest, tempest.MESSAGE_TOO_OLD_TO_BULK_DELETE_ERROR_CODE, "You can only bulk delete messages that are under 14 days old.")
+			return
+		}
+	}
+
+	server.mu.Lock()
+	server.state.Messages[channelID] = slices.DeleteFunc(server.state.Messages[channelID], func(msg tempest.Message) bool {
+		return slices.Contains(payload.Messages, msg.ID)
+	})
+	server.mu.Unlock()
+
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (server *Server) interactionCallback(w http.ResponseWriter, r *http.Request, entry *Request) {
+	interactionID, _ := tempest.StringToSnowflake(r.PathValue("interaction_id"))
+	token := r.PathValue("token")
+
+	raw, attachments, err := server.readPayload(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	var response struct {
+		Type tempest.ResponseType `json:"type"`
+		Data json.RawMessage      `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil || response.Type == 0 {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "invalid interaction response")
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if _, ok := server.state.Interactions[token]; ok {
+		writeError(w, http.StatusBadRequest, tempest.INTERACTION_ALREADY_ACKNOWLEDGED_ERROR_CODE, "Interaction has already been acknowledged.")
+		return
+	}
+
+	state := InteractionState{ID: interactionID, Response: raw}
+	switch response.Type {
+	case tempest.CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE, tempest.DEFERRED_CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE:
+		var msg tempest.Message
+		if len(response.Data) != 0 {
+			if err := json.Unmarshal(response.Data, &msg); err != nil {
+				writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+				return
+			}
+		}
+
+		msg.Attachments = attachments
+		if response.Type == tempest.DEFERRED_CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE {
+			msg.Flags |= tempest.LOADING_MESSAGE_FLAG
+		}
+
+		msg = server.newMessage(msg, 0)
+		state.Original = &msg
+	}
+
+	server.state.Interactions[token] = state
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (server *Server) createFollowup(w http.ResponseWriter, r *http.Request, entry *Request) {
+	msg, err := server.readMessage(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	token := r.PathValue("token")
+	state, ok := server.state.Interactions[token]
+	if !ok {
+		writeError(w, http.StatusNotFound, tempest.UNKNOWN_WEBHOOK_ERROR_CODE, "Unknown Webhook")
+		return
+	}
+
+	msg = server.newMessage(msg, 0)
+	state.Followups = append(state.Followups, msg)
+	server.state.Interactions[token] = state
+	writeJSON(w, http.StatusOK, msg)
+}
+
+func (server *Server) getWebhookMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	_, msg, ok := server.findWebhookMessage(w, r)
+	if ok {
+		writeJSON(w, http.StatusOK, *msg)
+	}
+}
+
+func (server *Server) editWebhookMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	patch, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	state, msg, ok := server.findWebhookMessage(w, r)
+	if !ok {
+		return
+	}
+
+	edited, err := patchMessage(*msg, patch)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	edited.Flags &^= tempest.LOADING_MESSAGE_FLAG
+	*msg = edited
+	server.state.Interactions[r.PathValue("token")] = state
+	writeJSON(w, http.StatusOK, edited)
+}
+
+func (server *Server) deleteWebhookMessage(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	state, msg, ok := server.findWebhookMessage(w, r)
+	if !ok {
+		return
+	}
+
+	if state.Original == msg {
+		state.Original = nil
+	} else {
+		state.Followups = slices.DeleteFunc(state.Followups, func(followup tempest.Message) bool {
+			return followup.ID == msg.ID
+		})
+	}
+
+	server.state.Interactions[r.PathValue("token")] = state
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (server *Server) listCommands(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	writeJSON(w, http.StatusOK, append([]map[string]any{}, server.state.Commands[guildOf(r)]...))
+}
+
+func (server *Server) overwriteCommands(w http.ResponseWriter, r *http.Request, entry *Request) {
+	raw, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	var commands []map[string]any
+	if err := json.Unmarshal(raw, &commands); err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "expected array of commands")
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	guildID := guildOf(r)
+	existing := server.state.Commands[guildID]
+	for i, cmd := range commands {
+		id := ""
+		for _, old := range existing {
+			if commandKey(old) == commandKey(cmd) {
+				id, _ = old["id"].(string)
+			}
+		}
+		commands[i] = server.newCommand(r, cmd, id)
+	}
+
+	server.state.Commands[guildID] = commands
+	writeJSON(w, http.StatusOK, append([]map[string]any{}, commands...))
+}
+
+// Creates command or replaces one with the same name & type, like Discord does.
+func (server *Server) createCommand(w http.ResponseWriter, r *http.Request, entry *Request) {
+	raw, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	var cmd map[string]any
+	if err := json.Unmarshal(raw, &cmd); err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "expected command object")
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	guildID := guildOf(r)
+	for i, old := range server.state.Commands[guildID] {
+		if commandKey(old) == commandKey(cmd) {
+			id, _ := old["id"].(string)
+			cmd = server.newCommand(r, cmd, id)
+			server.state.Commands[guildID][i] = cmd
+			writeJSON(w, http.StatusOK, cmd)
+			return
+		}
+	}
+
+	cmd = server.newCommand(r, cmd, "")
+	server.state.Commands[guildID] = append(server.state.Commands[guildID], cmd)
+	writeJSON(w, http.StatusCreated, cmd)
+}
+
+func (server *Server) getCommand(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if index, ok := server.findCommand(w, r); ok {
+		writeJSON(w, http.StatusOK, server.state.Commands[guildOf(r)][index])
+	}
+}
+
+func (server *Server) editCommand(w http.ResponseWriter, r *http.Request, entry *Request) {
+	raw, err := server.readBody(r, entry)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, err.Error())
+		return
+	}
+
+	var patch map[string]any
+	if err := json.Unmarshal(raw, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, tempest.INVALID_FORM_BODY_ERROR_CODE, "expected command object")
+		return
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	index, ok := server.findCommand(w, r)
+	if !ok {
+		return
+	}
+
+	cmd := server.state.Commands[guildOf(r)][index]
+	for key, value := range patch {
+		cmd[key] = value
+	}
+
+	cmd = server.newCommand(r, cmd, cmd["id"].(string))
+	server.state.Commands[guildOf(r)][index] = cmd
+	writeJSON(w, http.StatusOK, cmd)
+}
+
+func (server *Server) deleteCommand(w http.ResponseWriter, r *http.Request, entry *Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	index, ok := server.findCommand(w, r)
+	if !ok {
+		return
+	}
+
+	guildID := guildOf(r)
+	server.state.Commands[guildID] = slices.Delete(server.state.Commands[guildID], index, index+1)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+// Fills server side fields of new message. Caller must hold lock.
+func (server *Server) newMessage(msg tempest.Message, channelID tempest.Snowflake) tempest.Message {
+	now := time.Now().UTC()
+	msg.ID = server.nextID()
+	msg.ChannelID = channelID
+	msg.Timestamp = tempest.NewISOTimestamp(now)
+	msg.EditedTimestamp = tempest.ISOTimestamp{}
+	msg.Author = &tempest.User{ID: server.opt.ApplicationID, Bot: true}
+	msg.ApplicationID = server.opt.ApplicationID
+	return msg
+}
+
+// Fills server side fields of command, keeping given ID (if any). Caller must hold lock.
+func (server *Server) newCommand(r *http.Request, cmd map[string]any, id string) map[string]any {
+	if id == "" {
+		id = server.nextID().String()
+	}
+
+	cmd["id"] = id
+	cmd["application_id"] = r.PathValue("application_id")
+	cmd["version"] = server.nextID().String()
+	if guildID := r.PathValue("guild_id"); guildID != "" {
+		cmd["guild_id"] = guildID
+	}
+
+	if _, ok := cmd["type"]; !ok {
+		cmd["type"] = float64(tempest.CHAT_INPUT_COMMAND_TYPE)
+	}
+	return cmd
+}
+
+// Caller must hold lock.
+func (server *Server) findMessage(r *http.Request) (tempest.Message, int, bool) {
+	channelID, _ := tempest.StringToSnowflake(r.PathValue("channel_id"))
+	messageID, _ := tempest.StringToSnowflake(r.PathValue("message_id"))
+
+	for i, msg := range server.state.Messages[channelID] {
+		if msg.ID == messageID {
+			return msg, i, true
+		}
+	}
+	return tempest.Message{}, 0, false
+}
+
+// Returns interaction state & pointer to matching message inside it, or writes error response. Caller must hold lock.
+func (server *Server) findWebhookMessage(w http.ResponseWriter, r *http.Request) (InteractionState, *tempest.Message, bool) {
+	state, ok := server.state.Interactions[r.PathValue("token")]
+	if !ok {
+		writeError(w, http.StatusNotFound, tempest.UNKNOWN_WEBHOOK_ERROR_CODE, "Unknown Webhook")
+		return state, nil, false
+	}
+
+	messageID := r.PathValue("message_id")
+	if messageID == "@original" {
+		if state.Original == nil {
+			writeError(w, http.StatusNotFound, tempest.UNKNOWN_MESSAGE_ERROR_CODE, "Unknown Message")
+			return state, nil, false
+		}
+		return state, state.Original, true
+	}
+
+	id, _ := tempest.StringToSnowflake(messageID)
+	if state.Original != nil && state.Original.ID == id {
+		return state, state.Original, true
+	}
+
+	for i := range state.Followups {
+		if state.Followups[i].ID == id {
+			return state, &state.Followups[i], true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, tempest.UNKNOWN_MESSAGE_ERROR_CODE, "Unknown Message")
+	return state, nil, false
+}
+
+// Returns index of command from path or writes error response. Caller must hold lock.
+func (server *Server) findCommand(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id := r.PathValue("command_id")
+	for i, cmd := range server.state.Commands[guildOf(r)] {
+		if cmd["id"] == id {
+			return i, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, tempest.UNKNOWN_APPLICATION_COMMAND_ERROR_CODE, "Unknown application command")
+	return 0, false
+}
+
+// Reads message from JSON or multipart body. Uploaded files are added as attachments.
+func (server *Server) readMessage(r *http.Request, entry *Request) (tempest.Message, error) {
+	raw, attachments, err := server.readPayload(r, entry)
+	if err != nil {
+		return tempest.Message{}, err
+	}
+
+	var msg tempest.Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return tempest.Message{}, err
+	}
+
+	if msg.Content == "" && len(msg.Embeds) == 0 && len(msg.Components) == 0 && len(attachments) == 0 {
+		return tempest.Message{}, errors.New("cannot send an empty message")
+	}
+
+	msg.Attachments = attachments
+	return msg, nil
+}
+
+// Reads JSON body, rejecting uploads.
+func (server *Server) readBody(r *http.Request, entry *Request) (json.RawMessage, error) {
+	raw, _, err := server.readPayload(r, entry)
+	return raw, err
+}
+
+// Reads JSON body or "payload_json" part of multipart body together with uploaded files (turned into attachments).
+func (server *Server) readPayload(r *http.Request, entry *Request) (json.RawMessage, []tempest.Attachment, error) {
+	if r.Body == nil {
+		return nil, nil, nil
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(raw) != 0 && !json.Valid(raw) {
+			return nil, nil, errors.New("body is not valid JSON")
+		}
+
+		entry.Body = raw
+		return raw, nil, nil
+	}
+
+	var raw json.RawMessage
+	var attachments []tempest.Attachment
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		data, err := io.ReadAll(part)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if part.FormName() == "payload_json" {
+			raw = data
+			continue
+		}
+
+		server.mu.Lock()
+		id := server.nextID()
+		server.mu.Unlock()
+
+		url := tempest.DISCORD_CDN_URL + "/attachments/" + id.String() + "/" + part.FileName()
+		attachments = append(attachments, tempest.Attachment{
+			ID:          id,
+			FileName:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Size:        uint64(len(data)),
+			URL:         url,
+			ProxyURL:    url,
+		})
+		entry.Files = append(entry.Files, part.FileName())
+	}
+
+	entry.Body = raw
+	return raw, attachments, nil
+}
+
+// Applies partial update to message, keeping fields that weren't sent.
+func patchMessage(msg tempest.Message, patch json.RawMessage) (tempest.Message, error) {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return msg, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return msg, err
+	}
+
+	var changes map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &changes); err != nil {
+		return msg, err
+	}
+
+	for key, value := range changes {
+		switch key {
+		case "id", "channel_id", "author", "timestamp", "application_id":
+			continue
+		}
+		fields[key] = value
+	}
+
+	if raw, err = json.Marshal(fields); err != nil {
+		return msg, err
+	}
+
+	var res tempest.Message
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return msg, err
+	}
+
+	now := time.Now().UTC()
+	res.EditedTimestamp = tempest.NewISOTimestamp(now)
+	return res, nil
+}
+
+func guildOf(r *http.Request) tempest.Snowflake {
+	guildID, _ := tempest.StringToSnowflake(r.PathValue("guild_id"))
+	return guildID
+}
+
+// Commands are unique by name & type.
+func commandKey(cmd map[string]any) string {
+	name, _ := cmd["name"].(string)
+	kind, ok := cmd["type"].(float64)
+	if !ok {
+		kind = float64(tempest.CHAT_INPUT_COMMAND_TYPE)
+	}
+	return strconv.FormatFloat(kind, 'f', 0, 64) + ":" + name
+}
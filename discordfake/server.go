@@ -0,0 +1,308 @@
+// Package discordfake implements in-memory fake of Discord API, covering routes used by tempest (messages, interaction callbacks,
+// webhooks & application commands), so bots can be tested end to end without network access:
+//
+//	fake := discordfake.New(discordfake.Options{ApplicationID: appID})
+//	client := tempest.NewClient(tempest.ClientOptions{...})
+//	client.Rest.HTTPClient.Transport = fake
+//
+// State can be captured with Server.Snapshot (and compared against stored JSON) or loaded with Server.Restore.
+package discordfake
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+const API_PATH_PREFIX = "/api/v10"
+
+type Options struct {
+	ApplicationID tempest.Snowflake // Used as author of messages sent by bot.
+	Token         string            // Optional bot token, requests with other token are rejected with 401. Leave empty to accept any token.
+
+	// Optional rate limit simulation - number of requests allowed per bucket (route & its major parameter) within RateLimitWindow.
+	// Leave it at 0 to disable rate limits. Window defaults to 1 second.
+	RateLimit       int
+	RateLimitWindow time.Duration
+}
+
+// Request received by fake server, see Server.Requests.
+type Request struct {
+	Method string
+	Path   string          // Path without API prefix, like "/channels/123/messages".
+	Body   json.RawMessage // JSON body (or "payload_json" part of multipart body), if any.
+	Files  []string        // Names of uploaded files.
+	Status int
+}
+
+// Interaction (identified by token) that received callback, with its webhook messages.
+type InteractionState struct {
+	ID        tempest.Snowflake `json:"id"`
+	Response  json.RawMessage   `json:"response"` // Callback sent to Discord, like {"type":4,"data":{...}}.
+	Original  *tempest.Message  `json:"original,omitempty"`
+	Followups []tempest.Message `json:"followups,omitzero"`
+}
+
+// Serializable copy of whole fake server state.
+type State struct {
+	Messages     map[tempest.Snowflake][]tempest.Message `json:"messages"`     // Channel messages from oldest to newest, keyed by channel ID.
+	Commands     map[tempest.Snowflake][]map[string]any  `json:"commands"`     // Application commands keyed by guild ID, 0 for global commands.
+	Interactions map[string]InteractionState             `json:"interactions"` // Keyed by interaction token.
+}
+
+// Fake Discord API server. It implements both http.Handler (to mount it with httptest.Server) and
+// http.RoundTripper (to use it as Rest.HTTPClient.Transport, without any sockets).
+type Server struct {
+	opt    Options
+	mux    *http.ServeMux
+	mu     sync.Mutex
+	state  State
+	lastID tempest.Snowflake
+	log    []Request
+	limits map[string]*bucket
+}
+
+type bucket struct {
+	hash      string
+	remaining int
+	resetAt   time.Time
+}
+
+func New(opt Options) *Server {
+	if opt.RateLimitWindow <= 0 {
+		opt.RateLimitWindow = time.Second
+	}
+
+	server := &Server{
+		opt:    opt,
+		mux:    http.NewServeMux(),
+		limits: make(map[string]*bucket),
+	}
+	server.Reset()
+	server.routes()
+	return server
+}
+
+// Removes all stored state and request log.
+func (server *Server) Reset() {
+	server.Restore(State{})
+	server.mu.Lock()
+	server.log = nil
+	server.limits = make(map[string]*bucket)
+	server.mu.Unlock()
+}
+
+// Returns deep copy of current state.
+func (server *Server) Snapshot() State {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return cloneState(server.state)
+}
+
+// Replaces current state with given one (for example decoded from JSON file with earlier snapshot).
+func (server *Server) Restore(state State) {
+	state = cloneState(state)
+	if state.Messages == nil {
+		state.Messages = make(map[tempest.Snowflake][]tempest.Message)
+	}
+
+	if state.Commands == nil {
+		state.Commands = make(map[tempest.Snowflake][]map[string]any)
+	}
+
+	if state.Interactions == nil {
+		state.Interactions = make(map[string]InteractionState)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.state = state
+}
+
+// Returns copy of all requests received so far, in order of arrival.
+func (server *Server) Requests() []Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]Request(nil), server.log...)
+}
+
+// Returns messages sent to channel, from oldest to newest.
+func (server *Server) Messages(channelID tempest.Snowflake) []tempest.Message {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]tempest.Message(nil), server.state.Messages[channelID]...)
+}
+
+// Returns interaction state (callbacks & webhook messages) for given interaction token.
+func (server *Server) Interaction(token string) (InteractionState, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	state, ok := server.state.Interactions[token]
+	return state, ok
+}
+
+// Returns raw application commands registered in guild (use 0 for global commands).
+func (server *Server) Commands(guildID tempest.Snowflake) []map[string]any {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return cloneState(State{Commands: map[tempest.Snowflake][]map[string]any{guildID: server.state.Commands[guildID]}}).Commands[guildID]
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.Clone(r.Context())
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, API_PATH_PREFIX)
+	r.URL.RawPath = ""
+	server.mux.ServeHTTP(w, r)
+}
+
+// Handles request in process, so fake can be used as Rest.HTTPClient.Transport.
+func (server *Server) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := r.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, r)
+	if r.Body != nil {
+		io.Copy(io.Discard, r.Body) // Unblock streamed (multipart) bodies that handler didn't read.
+		r.Body.Close()
+	}
+
+	res := recorder.Result()
+	res.Request = r
+	return res, nil
+}
+
+// Registers route handler wrapped with auth check, rate limit simulation & request log.
+// Major is name of path value that splits route into separate buckets, like Discord does with channel IDs.
+func (server *Server) handle(pattern string, major string, authorized bool, fn func(w http.ResponseWriter, r *http.Request, entry *Request)) {
+	server.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		entry := Request{Method: r.Method, Path: r.URL.Path}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			entry.Status = recorder.status
+			server.mu.Lock()
+			server.log = append(server.log, entry)
+			server.mu.Unlock()
+		}()
+
+		if authorized && server.opt.Token != "" && r.Header.Get("Authorization") != "Bot "+server.opt.Token {
+			writeError(recorder, http.StatusUnauthorized, tempest.UNAUTHORIZED_ERROR_CODE, "401: Unauthorized")
+			return
+		}
+
+		if !server.allow(recorder, pattern, r.PathValue(major)) {
+			return
+		}
+
+		fn(recorder, r, &entry)
+	})
+}
+
+// Applies rate limit of bucket & writes rate limit headers. Returns false (after writing 429 response) if bucket is exhausted.
+func (server *Server) allow(w http.ResponseWriter, pattern string, major string) bool {
+	if server.opt.RateLimit <= 0 {
+		return true
+	}
+
+	server.mu.Lock()
+	now := time.Now()
+	limit, ok := server.limits[pattern+major]
+	if !ok {
+		hash := fnv.New64a()
+		hash.Write([]byte(pattern))
+		limit = &bucket{hash: strconv.FormatUint(hash.Sum64(), 16)}
+		server.limits[pattern+major] = limit
+	}
+
+	if !now.Before(limit.resetAt) {
+		limit.remaining = server.opt.RateLimit
+		limit.resetAt = now.Add(server.opt.RateLimitWindow)
+	}
+
+	allowed := limit.remaining > 0
+	if allowed {
+		limit.remaining--
+	}
+
+	remaining, resetAt := limit.remaining, limit.resetAt
+	server.mu.Unlock()
+
+	resetAfter := resetAt.Sub(now).Seconds()
+	header := w.Header()
+	header.Set("X-RateLimit-Limit", strconv.Itoa(server.opt.RateLimit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatFloat(float64(resetAt.UnixMilli())/1000, 'f', 3, 64))
+	header.Set("X-RateLimit-Reset-After", strconv.FormatFloat(resetAfter, 'f', 3, 64))
+	header.Set("X-RateLimit-Bucket", limit.hash)
+
+	if !allowed {
+		header.Set("X-RateLimit-Scope", "user")
+		header.Set("Retry-After", strconv.Itoa(int(resetAfter+1)))
+		writeJSON(w, http.StatusTooManyRequests, map[string]any{
+			"message":     "You are being rate limited.",
+			"retry_after": resetAfter,
+			"global":      false,
+		})
+	}
+
+	return allowed
+}
+
+// Returns new, unique snowflake based on current time. Caller must hold lock.
+func (server *Server) nextID() tempest.Snowflake {
+	id := tempest.SnowflakeFromTime(time.Now())
+	if id <= server.lastID {
+		id = server.lastID + 1
+	}
+
+	server.lastID = id
+	return id
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	if value == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", tempest.CONTENT_TYPE_JSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, status int, code tempest.ErrorCode, message string) {
+	writeJSON(w, status, tempest.RestError{Code: code, Message: message})
+}
+
+// Deep copies state by passing it through JSON, which is also how it's compared against stored snapshots.
+func cloneState(state State) State {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		panic("discordfake: failed to copy state: " + err.Error())
+	}
+
+	var res State
+	if err := json.Unmarshal(raw, &res); err != nil {
+		panic("discordfake: failed to copy state: " + err.Error())
+	}
+	return res
+}
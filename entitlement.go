@@ -1,6 +1,11 @@
 package tempest
 
-import "time"
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // https://discord.com/developers/docs/resources/entitlement#entitlement-object-entitlement-types
 type EntitlementType uint8
@@ -28,15 +33,109 @@ type Entitlement struct {
 	UserID        Snowflake       `json:"user_id,omitempty"` // ID of the user that is granted access to the entitlement's sku
 	Type          EntitlementType `json:"type"`
 	Deleted       bool            `json:"deleted,omitempty"` // Whether entitlement was deleted
-	StartsAt      *time.Time      `json:"starts_at"`
-	EndsAt        *time.Time      `json:"ends_at"`
+	StartsAt      ISOTimestamp    `json:"starts_at"`
+	EndsAt        ISOTimestamp    `json:"ends_at"`
 	GuildID       Snowflake       `json:"guild_id,omitempty"`
 	Consumed      bool            `json:"consumed,omitempty"` // Whether entitlement was already used
 }
 
+// Whether entitlement currently grants access - it's not deleted and (for subscriptions) within its time window.
+// Consumable entitlements stay active until consumed, check Entitlement.Consumed.
+func (entitlement Entitlement) Active() bool {
+	now := time.Now()
+	if entitlement.Deleted || (!entitlement.StartsAt.IsZero() && now.Before(entitlement.StartsAt.Time)) {
+		return false
+	}
+	return entitlement.EndsAt.IsZero() || now.Before(entitlement.EndsAt.Time)
+}
+
+// Optional filters for Client.FetchEntitlements. Zero values are skipped.
+//
+// https://discord.com/developers/docs/resources/entitlement#list-entitlements-query-string-params
+type EntitlementFilter struct {
+	UserID         Snowflake
+	SkuIDs         []Snowflake
+	GuildID        Snowflake
+	Before         Snowflake
+	After          Snowflake
+	Limit          uint8 // 1-100, defaults to 100.
+	ExcludeEnded   bool
+	ExcludeDeleted bool
+}
+
+func (filter EntitlementFilter) query() string {
+	query := url.Values{}
+	if filter.UserID != 0 {
+		query.Set("user_id", filter.UserID.String())
+	}
+
+	if len(filter.SkuIDs) != 0 {
+		ids := make([]string, len(filter.SkuIDs))
+		for i, id := range filter.SkuIDs {
+			ids[i] = id.String()
+		}
+		query.Set("sku_ids", strings.Join(ids, ","))
+	}
+
+	if filter.GuildID != 0 {
+		query.Set("guild_id", filter.GuildID.String())
+	}
+
+	if filter.Before != 0 {
+		query.Set("before", filter.Before.String())
+	}
+
+	if filter.After != 0 {
+		query.Set("after", filter.After.String())
+	}
+
+	if filter.Limit != 0 {
+		query.Set("limit", strconv.FormatUint(uint64(filter.Limit), 10))
+	}
+
+	if filter.ExcludeEnded {
+		query.Set("exclude_ended", "true")
+	}
+
+	if filter.ExcludeDeleted {
+		query.Set("exclude_deleted", "true")
+	}
+
+	return query.Encode()
+}
+
 // https://discord.com/developers/docs/resources/entitlement#create-test-entitlement-json-params
 type TestEntitlementPayload struct {
 	SkuID     Snowflake `json:"sku_id"`
 	OwnerID   Snowflake `json:"owner_id"`
 	OwnerType uint8     `json:"owner_type"` // 1 for a guild subscription, 2 for a user subscription
 }
+
+// https://discord.com/developers/docs/resources/sku#sku-object-sku-types
+type SkuType uint8
+
+const (
+	DURABLE_SKU_TYPE            SkuType = 2 // Durable one-time purchase.
+	CONSUMABLE_SKU_TYPE         SkuType = 3 // Consumable one-time purchase.
+	SUBSCRIPTION_SKU_TYPE       SkuType = 5 // Represents a recurring subscription.
+	SUBSCRIPTION_GROUP_SKU_TYPE SkuType = 6 // System-generated group for each SUBSCRIPTION_SKU_TYPE SKU created.
+)
+
+// https://discord.com/developers/docs/resources/sku#sku-object-sku-flags
+const (
+	AVAILABLE_SKU_FLAG          uint64 = 1 << 2 // SKU is available for purchase.
+	GUILD_SUBSCRIPTION_SKU_FLAG uint64 = 1 << 7 // Recurring SKU that can be purchased by a user and applied to a single guild.
+	USER_SUBSCRIPTION_SKU_FLAG  uint64 = 1 << 8 // Recurring SKU purchased by a user for themselves.
+)
+
+// SKUs (stock-keeping units) in Discord represent premium offerings that can be made available to your application's users or guilds.
+//
+// https://discord.com/developers/docs/resources/sku#sku-object
+type Sku struct {
+	ID            Snowflake `json:"id"`
+	Type          SkuType   `json:"type"`
+	ApplicationID Snowflake `json:"application_id"`
+	Name          string    `json:"name"`
+	Slug          string    `json:"slug"`
+	Flags         uint64    `json:"flags"`
+}
@@ -0,0 +1,155 @@
+package tempest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	EVENT_SUBJECT_PREFIX       = "tempest.event."       // Followed by event type, like "tempest.event.ENTITLEMENT_CREATE".
+	INTERACTION_SUBJECT_PREFIX = "tempest.interaction." // Followed by interaction type number, like "tempest.interaction.2".
+)
+
+// Forwards received webhook events (and optionally interactions) to message broker like NATS, Kafka or AMQP,
+// so receiving process can be separated from workers running handlers. Wrap client of your broker of choice, for example:
+//
+//	tempest.EventPublisherFunc(func(ctx context.Context, subject string, data []byte) error {
+//		return nc.Publish(subject, data) // NATS
+//	})
+//
+// Workers feed received messages back with Client.ConsumeEvent & Client.ConsumeInteraction, which run the same handlers as Client.EventHandler & Client.ServeHTTP.
+type EventPublisher interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+}
+
+// Adapts function into EventPublisher.
+type EventPublisherFunc func(ctx context.Context, subject string, data []byte) error
+
+func (fn EventPublisherFunc) Publish(ctx context.Context, subject string, data []byte) error {
+	return fn(ctx, subject, data)
+}
+
+// Decodes webhook event published by another client (check ClientOptions.EventPublisher) and runs matching handlers
+// registered with Client.OnEntitlementCreate, Client.OnRawEvent and others. It blocks until all handlers finish.
+func (client *Client) ConsumeEvent(data []byte) error {
+	var event EventBody
+	if err := unmarshalJSON(data, &event); err != nil {
+		return errors.New("failed to decode published event: " + err.Error())
+	}
+
+	if event.Type == "" {
+		return errors.New("published event is missing its type")
+	}
+
+	client.dispatchEvent(event)
+	return nil
+}
+
+func (client *Client) publishEvent(ctx context.Context, event EventBody) error {
+	data, err := marshalJSON(event)
+	if err != nil {
+		return err
+	}
+
+	return client.publisher.Publish(ctx, EVENT_SUBJECT_PREFIX+string(event.Type), data)
+}
+
+// Decodes interaction published by another client (check ClientOptions.PublishInteractions & PublishOnly) and runs matching handlers,
+// just like Client.ServeHTTP would. Since there's no HTTP request to answer, initial responses written by handlers
+// (like ComponentInteraction.Acknowledge or autocomplete choices) are sent through interaction callback endpoint instead.
+// It blocks until handler returns. Interaction token is only valid for 3 seconds until initial response, so consume them right away.
+func (client *Client) ConsumeInteraction(data []byte) error {
+	var interaction Interaction
+	if err := unmarshalJSON(data, &interaction); err != nil {
+		return errors.New("failed to decode published interaction: " + err.Error())
+	}
+
+	if interaction.Type == PING_INTERACTION_TYPE {
+		return nil
+	}
+
+	client.prepareInteraction(context.Background(), &interaction, data)
+	client.DispatchInteraction(&callbackResponseWriter{interaction: &interaction, header: make(http.Header)}, interaction)
+	return nil
+}
+
+// Keeps copy of raw interaction payload when interactions are published. Unless PublishOnly is set, it's published right away in background,
+// as mirror for analytics & auditing - interaction is still handled locally.
+func (client *Client) captureInteraction(interaction *Interaction, rawData []byte) {
+	if !client.publishInteractions || interaction.Type == PING_INTERACTION_TYPE {
+		return
+	}
+
+	interaction.raw = make([]byte, len(rawData))
+	copy(interaction.raw, rawData)
+
+	if client.publishOnly {
+		return
+	}
+
+	ctx := context.WithoutCancel(interaction.Context())
+	id, subject, raw := interaction.ID, interactionSubject(*interaction), interaction.raw
+	go func() {
+		if err := client.publisher.Publish(ctx, subject, raw); err != nil {
+			client.logger.Error("failed to publish interaction", "id", id, "error", err)
+		}
+	}()
+}
+
+// Publishes interaction for workers when PublishOnly is set and answers request, so Discord waits for callback sent by worker.
+// Returns false if interaction should be handled locally.
+func (client *Client) forwardInteraction(w http.ResponseWriter, interaction Interaction) bool {
+	if !client.publishOnly || interaction.raw == nil {
+		return false
+	}
+
+	defer interaction.Release()
+	if err := client.publisher.Publish(interaction.Context(), interactionSubject(interaction), interaction.raw); err != nil {
+		client.logger.Error("failed to publish interaction", "id", interaction.ID, "error", err)
+		http.Error(w, "service unavailable - failed to forward interaction", http.StatusServiceUnavailable)
+		return true
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+func interactionSubject(interaction Interaction) string {
+	return INTERACTION_SUBJECT_PREFIX + strconv.FormatUint(uint64(interaction.Type), 10)
+}
+
+// Sends initial response written by handlers of consumed interaction to callback endpoint.
+type callbackResponseWriter struct {
+	interaction *Interaction
+	header      http.Header
+	status      int
+}
+
+func (w *callbackResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *callbackResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *callbackResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.status >= http.StatusBadRequest {
+		w.interaction.Client.logger.Error("failed to handle consumed interaction", "id", w.interaction.ID, "status", w.status, "error", string(bytes.TrimSpace(data)))
+		return len(data), nil
+	}
+
+	itx := w.interaction
+	_, err := itx.Client.Rest.RequestWithContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", json.RawMessage(data))
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
@@ -0,0 +1,292 @@
+package tempest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"runtime/debug"
+	"sync"
+)
+
+// https://discord.com/developers/docs/events/webhook-events#webhook-types
+type WebhookType uint8
+
+const (
+	PING_WEBHOOK_TYPE WebhookType = iota
+	EVENT_WEBHOOK_TYPE
+)
+
+// https://discord.com/developers/docs/events/webhook-events#event-types
+type EventType string
+
+const (
+	APPLICATION_AUTHORIZED_EVENT_TYPE   EventType = "APPLICATION_AUTHORIZED"
+	APPLICATION_DEAUTHORIZED_EVENT_TYPE EventType = "APPLICATION_DEAUTHORIZED"
+	ENTITLEMENT_CREATE_EVENT_TYPE       EventType = "ENTITLEMENT_CREATE"
+)
+
+// https://discord.com/developers/docs/events/webhook-events#payload-structure
+type WebhookEventPayload struct {
+	Version       uint8       `json:"version"`
+	ApplicationID Snowflake   `json:"application_id"`
+	Type          WebhookType `json:"type"`
+	Event         *EventBody  `json:"event,omitempty"` // Missing for PING_WEBHOOK_TYPE.
+}
+
+// https://discord.com/developers/docs/events/webhook-events#event-body-object
+type EventBody struct {
+	Type      EventType       `json:"type"`
+	Timestamp ISOTimestamp    `json:"timestamp"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// https://discord.com/developers/docs/events/webhook-events#application-authorized-application-authorized-structure
+type ApplicationAuthorizedEvent struct {
+	IntegrationType *ApplicationIntegrationType `json:"integration_type,omitempty"` // Where app was installed, missing for plain OAuth2 authorizations.
+	User            User                        `json:"user"`
+	Scopes          []string                    `json:"scopes"`
+	Guild           *Guild                      `json:"guild,omitempty"` // Server app was added to (only for GUILD_INSTALL).
+}
+
+// https://discord.com/developers/docs/events/webhook-events#application-deauthorized-application-deauthorized-structure
+type ApplicationDeauthorizedEvent struct {
+	User User `json:"user"`
+}
+
+// Decides what happens with webhook event when all workers are busy and queue is full, see ClientOptions.EventWorkers.
+type EventOverflowPolicy uint8
+
+const (
+	BLOCK_EVENT_OVERFLOW EventOverflowPolicy = iota // Hold request until there's room in queue (default option).
+	DROP_EVENT_OVERFLOW                             // Skip event (it's still acknowledged) and log warning.
+	SPILL_EVENT_OVERFLOW                            // Handle event on its own goroutine, outside of worker pool.
+)
+
+const DEFAULT_EVENT_QUEUE_SIZE = 100
+
+// Keeps registered event handlers and routes decoded events to them.
+type eventDispatcher struct {
+	mu                   sync.RWMutex
+	handlers             map[EventType][]func(data json.RawMessage) error
+	rawHandlers          []func(eventType string, data json.RawMessage)
+	interactionObservers []func(itx *Interaction)
+
+	workers   int
+	overflow  EventOverflowPolicy
+	queue     chan EventBody // Nil unless worker pool is enabled.
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func newEventDispatcher(workers int, queueSize int, overflow EventOverflowPolicy) *eventDispatcher {
+	events := &eventDispatcher{handlers: make(map[EventType][]func(data json.RawMessage) error)}
+	if workers > 0 {
+		if queueSize <= 0 {
+			queueSize = DEFAULT_EVENT_QUEUE_SIZE
+		}
+
+		events.workers = workers
+		events.overflow = overflow
+		events.queue = make(chan EventBody, queueSize)
+	}
+	return events
+}
+
+// Returns number of webhook events waiting for free worker. It's always 0 without worker pool (check ClientOptions.EventWorkers).
+func (client *Client) EventQueueDepth() int {
+	return len(client.events.queue)
+}
+
+// Registers function called whenever app is installed to server or user account.
+// Requires "Events" webhook URL configured in Developer Portal & Client.EventHandler serving it.
+func (client *Client) OnApplicationAuthorized(fn func(event ApplicationAuthorizedEvent)) {
+	onEvent(client.events, APPLICATION_AUTHORIZED_EVENT_TYPE, fn)
+}
+
+// Registers function called whenever user deauthorizes app (removes it from their account).
+// Requires "Events" webhook URL configured in Developer Portal & Client.EventHandler serving it.
+func (client *Client) OnApplicationDeauthorized(fn func(event ApplicationDeauthorizedEvent)) {
+	onEvent(client.events, APPLICATION_DEAUTHORIZED_EVENT_TYPE, fn)
+}
+
+// Registers function called whenever user purchases or is granted SKU.
+// Requires "Events" webhook URL configured in Developer Portal & Client.EventHandler serving it.
+func (client *Client) OnEntitlementCreate(fn func(entitlement Entitlement)) {
+	onEvent(client.events, ENTITLEMENT_CREATE_EVENT_TYPE, fn)
+}
+
+// Registers function called for every received webhook event (before typed handlers), including event types
+// this library doesn't support yet. Data is left undecoded.
+func (client *Client) OnRawEvent(fn func(eventType string, data json.RawMessage)) {
+	client.events.mu.Lock()
+	client.events.rawHandlers = append(client.events.rawHandlers, fn)
+	client.events.mu.Unlock()
+}
+
+// Registers function called for each received interaction (except pings), right before it's routed to matching handler.
+// It runs on the same goroutine as interaction handlers so keep it fast - use it for things like logging or analytics.
+func (client *Client) OnInteractionCreate(fn func(itx *Interaction)) {
+	client.events.mu.Lock()
+	client.events.interactionObservers = append(client.events.interactionObservers, fn)
+	client.events.mu.Unlock()
+}
+
+// Handles incoming Discord webhook event requests. Mount it under URL set as "Events" webhook URL in Developer Portal.
+// It verifies & acknowledges request right away, registered handlers run in background afterwards.
+func (client *Client) EventHandler(w http.ResponseWriter, r *http.Request) {
+	if !client.lifecycle.begin() {
+		http.Error(w, "service unavailable - shutting down", http.StatusServiceUnavailable)
+		return
+	}
+
+	dispatched := false
+	defer func() {
+		if !dispatched {
+			client.lifecycle.end()
+		}
+	}()
+
+	if !client.VerifyRequest(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	limitedReader := http.MaxBytesReader(w, r.Body, MAX_REQUEST_BODY_SIZE)
+	buf, err := readPooled(limitedReader)
+	defer releaseBuffer(buf)
+	limitedReader.Close()
+	rawData := buf.Bytes()
+	if err != nil {
+		http.Error(w, "bad request - failed to read body payload", http.StatusBadRequest)
+		return
+	}
+
+	var payload WebhookEventPayload
+	if err := unmarshalJSON(rawData, &payload); err != nil {
+		http.Error(w, "bad request - invalid body json payload", http.StatusBadRequest)
+		return
+	}
+
+	isEvent := payload.Type == EVENT_WEBHOOK_TYPE && payload.Event != nil
+	if isEvent && client.publisher != nil {
+		if err := client.publishEvent(r.Context(), *payload.Event); err != nil {
+			client.logger.Error("failed to publish webhook event", "type", payload.Event.Type, "error", err)
+			if client.publishOnly {
+				http.Error(w, "service unavailable - failed to forward event", http.StatusServiceUnavailable)
+				return
+			}
+		}
+	}
+
+	// Discord expects empty 204 response for both pings and events.
+	w.WriteHeader(http.StatusNoContent)
+
+	if !isEvent || client.publishOnly {
+		return
+	}
+
+	client.logger.Debug("received webhook event", "type", payload.Event.Type)
+	dispatched = client.enqueueEvent(*payload.Event)
+}
+
+// Hands event over to worker pool (or new goroutine). Returns false if event was dropped.
+// Each handed over event keeps lifecycle entry until it's handled, so Client.Shutdown waits for queued events too.
+func (client *Client) enqueueEvent(event EventBody) bool {
+	events := client.events
+	if events.queue == nil {
+		go client.runEvent(event)
+		return true
+	}
+
+	events.startOnce.Do(func() {
+		for range events.workers {
+			go func() {
+				for event := range events.queue {
+					client.runEvent(event)
+				}
+			}()
+		}
+	})
+
+	select {
+	case events.queue <- event:
+		return true
+	default:
+	}
+
+	switch events.overflow {
+	case DROP_EVENT_OVERFLOW:
+		client.logger.Warn("dropped webhook event - event queue is full", "type", event.Type, "queue_size", cap(events.queue))
+		return false
+	case SPILL_EVENT_OVERFLOW:
+		go client.runEvent(event)
+	default:
+		events.queue <- event
+	}
+	return true
+}
+
+func (client *Client) runEvent(event EventBody) {
+	defer client.lifecycle.end()
+	client.dispatchEvent(event)
+}
+
+// Stops worker pool. It must be called only once no more events can be enqueued (after lifecycle was closed & drained).
+func (events *eventDispatcher) stop() {
+	if events.queue != nil {
+		events.stopOnce.Do(func() { close(events.queue) })
+	}
+}
+
+func (client *Client) dispatchEvent(event EventBody) {
+	defer func() {
+		if r := recover(); r != nil {
+			client.logger.Error("recovered panic in webhook event handler", "type", event.Type, "panic", r, "stack", string(debug.Stack()))
+		}
+	}()
+
+	client.events.mu.RLock()
+	rawHandlers, handlers := client.events.rawHandlers, client.events.handlers[event.Type]
+	client.events.mu.RUnlock()
+
+	for _, handler := range rawHandlers {
+		handler(string(event.Type), event.Data)
+	}
+
+	if len(handlers) == 0 && len(rawHandlers) == 0 {
+		client.logger.Debug("no handler for webhook event", "type", event.Type)
+	}
+
+	for _, handler := range handlers {
+		if err := handler(event.Data); err != nil {
+			client.logger.Error("failed to handle webhook event", "type", event.Type, "error", err)
+		}
+	}
+}
+
+func (client *Client) observeInteractionCreate(itx *Interaction) {
+	client.events.mu.RLock()
+	observers := client.events.interactionObservers
+	client.events.mu.RUnlock()
+
+	for _, observer := range observers {
+		observer(itx)
+	}
+}
+
+// Wraps typed handler into function that decodes event data first.
+func onEvent[T any](events *eventDispatcher, eventType EventType, fn func(event T)) {
+	handler := func(data json.RawMessage) error {
+		var event T
+		if err := unmarshalJSON(data, &event); err != nil {
+			return errors.New("failed to decode " + string(eventType) + " event data: " + err.Error())
+		}
+
+		fn(event)
+		return nil
+	}
+
+	events.mu.Lock()
+	events.handlers[eventType] = append(events.handlers[eventType], handler)
+	events.mu.Unlock()
+}
@@ -0,0 +1,154 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	MAX_FORUM_TAGS         = 20 // Max number of tags forum or media channel can have.
+	MAX_APPLIED_FORUM_TAGS = 5  // Max number of tags single post can have.
+	MAX_FORUM_TAG_NAME     = 20 // Max length of tag name, in characters.
+)
+
+// https://discord.com/developers/docs/resources/channel#forum-tag-object
+type ForumTag struct {
+	ID        Snowflake `json:"id,omitempty"` // Leave it empty when creating new tag.
+	Name      string    `json:"name"`
+	Moderated bool      `json:"moderated"`            // Whether only members with MANAGE_THREADS permission can apply it.
+	EmojiID   Snowflake `json:"emoji_id,omitempty"`   // ID of custom emoji.
+	EmojiName string    `json:"emoji_name,omitempty"` // Unicode emoji.
+}
+
+// https://discord.com/developers/docs/resources/channel#default-reaction-object
+type DefaultReaction struct {
+	EmojiID   Snowflake `json:"emoji_id,omitempty"`   // ID of custom emoji.
+	EmojiName string    `json:"emoji_name,omitempty"` // Unicode emoji.
+}
+
+// https://discord.com/developers/docs/resources/channel#start-thread-in-forum-or-media-channel-jsonform-params
+type ThreadMessageParams struct {
+	Name                string      `json:"name"`                            // 1-100 characters.
+	AutoArchiveDuration uint16      `json:"auto_archive_duration,omitempty"` // In minutes: 60, 1440, 4320 or 10080.
+	RateLimitPerUser    uint16      `json:"rate_limit_per_user,omitempty"`   // Slowmode in seconds, 0-21600.
+	Message             Message     `json:"message"`                         // First message of post. Media channels require it to have an attachment.
+	AppliedTags         []Snowflake `json:"applied_tags,omitzero"`           // Up to 5 tag IDs from Channel.AvailableTags.
+}
+
+// Forum or media post - thread channel together with its first message.
+type ForumPost struct {
+	Channel
+	Message *Message `json:"message,omitempty"`
+}
+
+// Mirror method to Client.CreateForumPostWithContext but with background context.
+func (client *Client) CreateForumPost(channelID Snowflake, params ThreadMessageParams, files []File) (ForumPost, error) {
+	return client.CreateForumPostWithContext(context.Background(), channelID, params, files)
+}
+
+// Creates new post in forum or media channel. Requires SEND_MESSAGES permission.
+//
+// https://discord.com/developers/docs/resources/channel#start-thread-in-forum-or-media-channel
+func (client *Client) CreateForumPostWithContext(ctx context.Context, channelID Snowflake, params ThreadMessageParams, files []File) (ForumPost, error) {
+	length := utf8.RuneCountInString(params.Name)
+	if length == 0 || length > MAX_CHANNEL_NAME_LENGTH {
+		return ForumPost{}, errors.New("forum post name has to be between 1 and 100 characters")
+	}
+
+	if len(params.AppliedTags) > MAX_APPLIED_FORUM_TAGS {
+		return ForumPost{}, errors.New("forum post can have at most 5 applied tags")
+	}
+
+	raw, err := client.Rest.RequestWithFilesContext(ctx, http.MethodPost, "/channels/"+channelID.String()+"/threads", params, files)
+	if err != nil {
+		return ForumPost{}, err
+	}
+
+	res := ForumPost{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return ForumPost{}, errors.New("failed to parse received data from discord")
+	}
+
+	if client.Names != nil {
+		client.Names.SetChannelName(res.ID, res.Name)
+	}
+
+	return res, nil
+}
+
+// Replaces tags applied to forum or media post. Requires MANAGE_THREADS permission (or being post owner).
+func (client *Client) SetPostTags(postID Snowflake, tagIDs []Snowflake) (Channel, error) {
+	if len(tagIDs) > MAX_APPLIED_FORUM_TAGS {
+		return Channel{}, errors.New("forum post can have at most 5 applied tags")
+	}
+
+	if tagIDs == nil {
+		tagIDs = make([]Snowflake, 0)
+	}
+
+	return client.ModifyChannel(postID, ModifyChannelPayload{AppliedTags: tagIDs})
+}
+
+// Replaces all available tags of forum or media channel. Keep IDs of existing tags you want to preserve,
+// tags without ID are created and tags missing from the list are deleted. Requires MANAGE_CHANNELS permission.
+func (client *Client) SetForumTags(channelID Snowflake, tags []ForumTag) (Channel, error) {
+	if err := validateForumTags(tags); err != nil {
+		return Channel{}, err
+	}
+
+	if tags == nil {
+		tags = make([]ForumTag, 0)
+	}
+
+	return client.ModifyChannel(channelID, ModifyChannelPayload{AvailableTags: tags})
+}
+
+// Adds tag to forum or media channel, keeping its current tags. Returns updated channel - check its AvailableTags for ID of new tag.
+func (client *Client) AddForumTag(channelID Snowflake, tag ForumTag) (Channel, error) {
+	channel, err := client.FetchChannel(channelID)
+	if err != nil {
+		return Channel{}, err
+	}
+
+	tag.ID = 0
+	return client.SetForumTags(channelID, append(channel.AvailableTags, tag))
+}
+
+// Removes tag from forum or media channel. It's also removed from all posts that had it applied.
+func (client *Client) RemoveForumTag(channelID Snowflake, tagID Snowflake) (Channel, error) {
+	channel, err := client.FetchChannel(channelID)
+	if err != nil {
+		return Channel{}, err
+	}
+
+	tags := make([]ForumTag, 0, len(channel.AvailableTags))
+	for _, tag := range channel.AvailableTags {
+		if tag.ID != tagID {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(tags) == len(channel.AvailableTags) {
+		return channel, nil
+	}
+
+	return client.SetForumTags(channelID, tags)
+}
+
+func validateForumTags(tags []ForumTag) error {
+	if len(tags) > MAX_FORUM_TAGS {
+		return errors.New("forum channel can have at most 20 tags")
+	}
+
+	for _, tag := range tags {
+		length := utf8.RuneCountInString(tag.Name)
+		if length == 0 || length > MAX_FORUM_TAG_NAME {
+			return errors.New("forum tag name has to be between 1 and 20 characters")
+		}
+	}
+
+	return nil
+}
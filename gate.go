@@ -0,0 +1,65 @@
+package tempest
+
+import "time"
+
+// Returns how long ago user's account was created (based on user ID).
+func (user User) AccountAge() time.Duration {
+	return time.Since(user.ID.CreationTimestamp())
+}
+
+// Returns how long ago member joined guild. Returns 0 if join date is unknown.
+func (member Member) JoinAge() time.Duration {
+	return member.JoinedAt.Since()
+}
+
+// Reason why member didn't pass GateConfig check.
+type GateFailure uint8
+
+const (
+	NO_GATE_FAILURE                  GateFailure = iota // Member passed all checks.
+	ACCOUNT_TOO_NEW_GATE_FAILURE                        // Account is younger than GateConfig.MinAccountAge.
+	JOINED_TOO_RECENTLY_GATE_FAILURE                    // Member joined guild later than GateConfig.MinJoinAge ago (or join date is unknown).
+)
+
+// Policy used by anti-raid & verification features to decide whether member is trusted enough.
+// Zero value lets everyone through.
+type GateConfig struct {
+	MinAccountAge time.Duration // Minimum age of user's account.
+	MinJoinAge    time.Duration // Minimum time since member joined guild.
+}
+
+// Checks member against policy. Returns reason of failure & how long member has to wait until they pass it
+// (longest wait if multiple checks failed). Returns NO_GATE_FAILURE and 0 if member passed.
+func (config GateConfig) Evaluate(member Member) (GateFailure, time.Duration) {
+	failure := NO_GATE_FAILURE
+	var wait time.Duration
+
+	if config.MinAccountAge > 0 && member.User != nil {
+		if age := member.User.AccountAge(); age < config.MinAccountAge {
+			failure = ACCOUNT_TOO_NEW_GATE_FAILURE
+			wait = config.MinAccountAge - age
+		}
+	}
+
+	if config.MinJoinAge > 0 {
+		if member.JoinedAt.IsZero() {
+			if failure == NO_GATE_FAILURE {
+				failure = JOINED_TOO_RECENTLY_GATE_FAILURE
+				wait = config.MinJoinAge
+			}
+		} else if age := member.JoinAge(); age < config.MinJoinAge {
+			if failure == NO_GATE_FAILURE {
+				failure = JOINED_TOO_RECENTLY_GATE_FAILURE
+			}
+			wait = max(wait, config.MinJoinAge-age)
+		}
+	}
+
+	return failure, wait
+}
+
+// Shorthand for checking whether member passes all checks.
+func (config GateConfig) Passes(member Member) bool {
+	failure, _ := config.Evaluate(member)
+	return failure == NO_GATE_FAILURE
+}
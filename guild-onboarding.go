@@ -0,0 +1,159 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+)
+
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-onboarding-mode
+type OnboardingMode uint8
+
+const (
+	DEFAULT_ONBOARDING_MODE  OnboardingMode = iota // Counts only default channels towards constraints.
+	ADVANCED_ONBOARDING_MODE                       // Counts default channels & questions towards constraints.
+)
+
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-prompt-types
+type OnboardingPromptType uint8
+
+const (
+	MULTIPLE_CHOICE_PROMPT_TYPE OnboardingPromptType = iota
+	DROPDOWN_PROMPT_TYPE
+)
+
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-guild-onboarding-structure
+type Onboarding struct {
+	GuildID           Snowflake          `json:"guild_id,omitempty"`
+	Prompts           []OnboardingPrompt `json:"prompts"`
+	DefaultChannelIDs []Snowflake        `json:"default_channel_ids"` // Channels that members get opted into automatically.
+	Enabled           bool               `json:"enabled"`
+	Mode              OnboardingMode     `json:"mode"`
+}
+
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-onboarding-prompt-structure
+type OnboardingPrompt struct {
+	ID           Snowflake                `json:"id"` // Use any unique value (like SnowflakeFromTime) when creating new prompt.
+	Type         OnboardingPromptType     `json:"type"`
+	Options      []OnboardingPromptOption `json:"options"`
+	Title        string                   `json:"title"`
+	SingleSelect bool                     `json:"single_select"`
+	Required     bool                     `json:"required"`
+	InOnboarding bool                     `json:"in_onboarding"` // Whether prompt is shown in onboarding flow. Otherwise it's only in Channels & Roles tab.
+}
+
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-prompt-option-structure
+type OnboardingPromptOption struct {
+	ID          Snowflake   `json:"id"`
+	ChannelIDs  []Snowflake `json:"channel_ids"` // Channels member is added to when option is selected.
+	RoleIDs     []Snowflake `json:"role_ids"`    // Roles assigned to member when option is selected.
+	Emoji       *Emoji      `json:"emoji,omitempty"`
+	Title       string      `json:"title"`
+	Description string      `json:"description,omitempty"`
+}
+
+// https://discord.com/developers/docs/resources/guild#welcome-screen-object-welcome-screen-structure
+type WelcomeScreen struct {
+	Description     string                 `json:"description,omitempty"`
+	WelcomeChannels []WelcomeScreenChannel `json:"welcome_channels"` // Up to 5 channels.
+}
+
+// https://discord.com/developers/docs/resources/guild#welcome-screen-object-welcome-screen-channel-structure
+type WelcomeScreenChannel struct {
+	ChannelID   Snowflake `json:"channel_id"`
+	Description string    `json:"description"`
+	EmojiID     Snowflake `json:"emoji_id,omitempty"`   // ID of custom emoji.
+	EmojiName   string    `json:"emoji_name,omitempty"` // Unicode emoji or name of custom emoji.
+}
+
+// All fields are optional - only the ones you set will be modified.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-welcome-screen-json-params
+type ModifyWelcomeScreenPayload struct {
+	Enabled         *bool                  `json:"enabled,omitempty"` // Use pointer so it's possible to disable it.
+	WelcomeChannels []WelcomeScreenChannel `json:"welcome_channels,omitzero"`
+	Description     *string                `json:"description,omitempty"` // Use pointer so it's possible to clear it.
+}
+
+// https://discord.com/developers/docs/resources/guild#get-guild-onboarding
+func (client *Client) FetchOnboarding(guildID Snowflake) (Onboarding, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String()+"/onboarding", nil)
+	if err != nil {
+		return Onboarding{}, err
+	}
+
+	res := Onboarding{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Onboarding{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Replaces whole onboarding configuration of guild (prompts missing from payload are removed) and returns updated one.
+// Requires MANAGE_GUILD & MANAGE_ROLES permissions. Discord validates constraints (like minimal number of default channels) on its side.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-onboarding
+func (client *Client) ModifyOnboarding(guildID Snowflake, onboarding Onboarding) (Onboarding, error) {
+	onboarding.GuildID = 0
+	if onboarding.Prompts == nil {
+		onboarding.Prompts = make([]OnboardingPrompt, 0)
+	}
+
+	if onboarding.DefaultChannelIDs == nil {
+		onboarding.DefaultChannelIDs = make([]Snowflake, 0)
+	}
+
+	raw, err := client.Rest.Request(http.MethodPut, "/guilds/"+guildID.String()+"/onboarding", onboarding)
+	if err != nil {
+		return Onboarding{}, err
+	}
+
+	res := Onboarding{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Onboarding{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Requires MANAGE_GUILD permission if welcome screen is not enabled.
+//
+// https://discord.com/developers/docs/resources/guild#get-guild-welcome-screen
+func (client *Client) FetchWelcomeScreen(guildID Snowflake) (WelcomeScreen, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String()+"/welcome-screen", nil)
+	if err != nil {
+		return WelcomeScreen{}, err
+	}
+
+	res := WelcomeScreen{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return WelcomeScreen{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Requires MANAGE_GUILD permission. Works only in community guilds. Returns updated welcome screen.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-welcome-screen
+func (client *Client) ModifyWelcomeScreen(guildID Snowflake, payload ModifyWelcomeScreenPayload) (WelcomeScreen, error) {
+	if len(payload.WelcomeChannels) > 5 {
+		return WelcomeScreen{}, errors.New("welcome screen can have at most 5 channels")
+	}
+
+	raw, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String()+"/welcome-screen", payload)
+	if err != nil {
+		return WelcomeScreen{}, err
+	}
+
+	res := WelcomeScreen{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return WelcomeScreen{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,104 @@
+package tempest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// https://discord.com/developers/docs/resources/guild-template#guild-template-object-guild-template-structure
+type GuildTemplate struct {
+	Code                  string          `json:"code"`
+	Name                  string          `json:"name"`
+	Description           string          `json:"description,omitempty"`
+	UsageCount            uint32          `json:"usage_count"`
+	CreatorID             Snowflake       `json:"creator_id"`
+	Creator               *User           `json:"creator,omitempty"`
+	CreatedAt             ISOTimestamp    `json:"created_at"`
+	UpdatedAt             ISOTimestamp    `json:"updated_at"`
+	SourceGuildID         Snowflake       `json:"source_guild_id"`
+	SerializedSourceGuild json.RawMessage `json:"serialized_source_guild,omitempty"` // Snapshot of guild (roles, channels & settings) stored in template.
+	Dirty                 bool            `json:"is_dirty,omitempty"`                // Whether source guild changed since last sync, check Client.SyncGuildTemplate.
+}
+
+// Returns link that can be used to create new guild from template.
+func (template GuildTemplate) URL() string {
+	return "https://discord.new/" + template.Code
+}
+
+// https://discord.com/developers/docs/resources/guild-template#create-guild-template-json-params
+type GuildTemplatePayload struct {
+	Name        string `json:"name,omitempty"`        // 1-100 characters, required when creating template.
+	Description string `json:"description,omitempty"` // 0-120 characters.
+}
+
+// https://discord.com/developers/docs/resources/guild-template#get-guild-template
+func (client *Client) FetchGuildTemplate(code string) (GuildTemplate, error) {
+	return client.guildTemplateRequest(http.MethodGet, "/guilds/templates/"+code, nil)
+}
+
+// Requires MANAGE_GUILD permission.
+//
+// https://discord.com/developers/docs/resources/guild-template#get-guild-templates
+func (client *Client) FetchGuildTemplates(guildID Snowflake) ([]GuildTemplate, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String()+"/templates", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]GuildTemplate, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Creates template from current state of guild. Requires MANAGE_GUILD permission.
+//
+// https://discord.com/developers/docs/resources/guild-template#create-guild-template
+func (client *Client) CreateGuildTemplate(guildID Snowflake, payload GuildTemplatePayload) (GuildTemplate, error) {
+	if payload.Name == "" {
+		return GuildTemplate{}, errors.New("guild template name cannot be empty")
+	}
+
+	return client.guildTemplateRequest(http.MethodPost, "/guilds/"+guildID.String()+"/templates", payload)
+}
+
+// Updates template to match current state of its source guild. Requires MANAGE_GUILD permission.
+//
+// https://discord.com/developers/docs/resources/guild-template#sync-guild-template
+func (client *Client) SyncGuildTemplate(guildID Snowflake, code string) (GuildTemplate, error) {
+	return client.guildTemplateRequest(http.MethodPut, "/guilds/"+guildID.String()+"/templates/"+code, nil)
+}
+
+// Changes template's name or description. Requires MANAGE_GUILD permission.
+//
+// https://discord.com/developers/docs/resources/guild-template#modify-guild-template
+func (client *Client) ModifyGuildTemplate(guildID Snowflake, code string, payload GuildTemplatePayload) (GuildTemplate, error) {
+	return client.guildTemplateRequest(http.MethodPatch, "/guilds/"+guildID.String()+"/templates/"+code, payload)
+}
+
+// Requires MANAGE_GUILD permission.
+//
+// https://discord.com/developers/docs/resources/guild-template#delete-guild-template
+func (client *Client) DeleteGuildTemplate(guildID Snowflake, code string) error {
+	_, err := client.Rest.Request(http.MethodDelete, "/guilds/"+guildID.String()+"/templates/"+code, nil)
+	return err
+}
+
+func (client *Client) guildTemplateRequest(method string, route string, payload any) (GuildTemplate, error) {
+	raw, err := client.Rest.Request(method, route, payload)
+	if err != nil {
+		return GuildTemplate{}, err
+	}
+
+	res := GuildTemplate{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return GuildTemplate{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,80 @@
+package tempest
+
+// https://discord.com/developers/docs/resources/guild#guild-object-verification-level
+type VerificationLevel uint8
+
+const (
+	NONE_VERIFICATION_LEVEL VerificationLevel = iota
+	LOW_VERIFICATION_LEVEL
+	MEDIUM_VERIFICATION_LEVEL
+	HIGH_VERIFICATION_LEVEL
+	VERY_HIGH_VERIFICATION_LEVEL
+)
+
+// https://discord.com/developers/docs/resources/guild#guild-object-premium-tier
+type PremiumTier uint8
+
+const (
+	NONE_PREMIUM_TIER PremiumTier = iota
+	TIER_1_PREMIUM_TIER
+	TIER_2_PREMIUM_TIER
+	TIER_3_PREMIUM_TIER
+)
+
+// https://discord.com/developers/docs/resources/guild#guild-object-guild-structure
+type Guild struct {
+	ID                        Snowflake         `json:"id"`
+	Name                      string            `json:"name"`
+	IconHash                  string            `json:"icon,omitempty"`
+	SplashHash                string            `json:"splash,omitempty"`
+	DiscoverySplashHash       string            `json:"discovery_splash,omitempty"`
+	OwnerID                   Snowflake         `json:"owner_id"`
+	AFKChannelID              Snowflake         `json:"afk_channel_id,omitempty"`
+	AFKTimeout                uint16            `json:"afk_timeout"` // In seconds.
+	VerificationLevel         VerificationLevel `json:"verification_level"`
+	Roles                     []Role            `json:"roles"`
+	Emojis                    []Emoji           `json:"emojis"`
+	Features                  []string          `json:"features"`
+	SystemChannelID           Snowflake         `json:"system_channel_id,omitempty"`
+	RulesChannelID            Snowflake         `json:"rules_channel_id,omitempty"`
+	PublicUpdatesChannelID    Snowflake         `json:"public_updates_channel_id,omitempty"`
+	VanityURLCode             string            `json:"vanity_url_code,omitempty"`
+	Description               string            `json:"description,omitempty"`
+	BannerHash                string            `json:"banner,omitempty"`
+	PreferredLocale           Language          `json:"preferred_locale"`
+	PremiumTier               PremiumTier       `json:"premium_tier"`
+	PremiumSubscriptionCount  uint32            `json:"premium_subscription_count,omitempty"` // Number of boosts guild currently has.
+	PremiumProgressBarEnabled bool              `json:"premium_progress_bar_enabled"`
+	ApproximateMemberCount    uint32            `json:"approximate_member_count,omitempty"` // Only available when fetched with "with_counts" query.
+}
+
+// Returns a direct url to guild's icon. It'll return empty string if guild has no icon.
+func (guild Guild) IconURL() string {
+	return GuildIconURL(guild.ID, guild.IconHash, ImageOptions{})
+}
+
+// Returns a direct url to guild's banner. It'll return empty string if guild has no banner.
+func (guild Guild) BannerURL() string {
+	return GuildBannerURL(guild.ID, guild.BannerHash, ImageOptions{})
+}
+
+// Returns a direct url to guild's invite splash. It'll return empty string if guild has no splash.
+func (guild Guild) SplashURL() string {
+	return GuildSplashURL(guild.ID, guild.SplashHash, ImageOptions{})
+}
+
+// All fields are optional - only the ones you set will be modified.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-json-params
+type ModifyGuildPayload struct {
+	Name                      string             `json:"name,omitempty"`
+	VerificationLevel         *VerificationLevel `json:"verification_level,omitempty"`
+	AFKChannelID              Option[Snowflake]  `json:"afk_channel_id,omitzero"`
+	AFKTimeout                uint16             `json:"afk_timeout,omitempty"` // In seconds.
+	SystemChannelID           Option[Snowflake]  `json:"system_channel_id,omitzero"`
+	RulesChannelID            Option[Snowflake]  `json:"rules_channel_id,omitzero"`
+	PublicUpdatesChannelID    Option[Snowflake]  `json:"public_updates_channel_id,omitzero"`
+	PreferredLocale           Language           `json:"preferred_locale,omitempty"`
+	Description               Option[string]     `json:"description,omitzero"`
+	PremiumProgressBarEnabled *bool              `json:"premium_progress_bar_enabled,omitempty"` // Use pointer so it's possible to disable it.
+}
@@ -0,0 +1,71 @@
+package tempest
+
+import "net/http"
+
+// State of client reported by Client.HealthHandler & Client.ReadyHandler.
+type HealthReport struct {
+	Ready           bool       `json:"ready"`
+	Reason          string     `json:"reason,omitempty"` // Why client isn't ready, empty when it is.
+	ShuttingDown    bool       `json:"shutting_down"`
+	ActiveHandlers  int        `json:"active_handlers"`   // Interactions & webhook events being handled right now.
+	EventQueueDepth int        `json:"event_queue_depth"` // Always 0 unless ClientOptions.EventWorkers is set.
+	EventQueueSize  int        `json:"event_queue_size"`
+	Rest            RestHealth `json:"rest"`
+}
+
+// Returns current state of client. It isn't ready while shutting down or while webhook event queue is full.
+// Degraded Discord API is only reported - it's shared by all replicas, so failing readiness on it would take all of them out of load balancer at once.
+func (client *Client) Health() HealthReport {
+	client.lifecycle.mu.Lock()
+	report := HealthReport{
+		ShuttingDown:   client.lifecycle.closing,
+		ActiveHandlers: client.lifecycle.active,
+	}
+	client.lifecycle.mu.Unlock()
+
+	report.EventQueueDepth = len(client.events.queue)
+	report.EventQueueSize = cap(client.events.queue)
+	report.Rest = client.Rest.Health()
+
+	switch {
+	case report.ShuttingDown:
+		report.Reason = "shutting down"
+	case report.EventQueueSize != 0 && report.EventQueueDepth >= report.EventQueueSize:
+		report.Reason = "event queue is full"
+	default:
+		report.Ready = true
+	}
+
+	return report
+}
+
+// Liveness probe (like Kubernetes "/healthz") - it always responds with 200 OK and JSON encoded Client.Health,
+// as long as process can serve requests. Client that is shutting down is still alive, so it isn't restarted while draining.
+func (client *Client) HealthHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthReport(w, http.StatusOK, client.Health())
+}
+
+// Readiness probe (like Kubernetes "/readyz") - it responds with JSON encoded Client.Health and
+// 200 OK when client is ready or 503 Service Unavailable otherwise, so load balancer stops routing traffic to it.
+func (client *Client) ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	report := client.Health()
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	writeHealthReport(w, status, report)
+}
+
+func writeHealthReport(w http.ResponseWriter, status int, report HealthReport) {
+	body, err := marshalJSON(report)
+	if err != nil {
+		http.Error(w, "failed to encode health report", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", CONTENT_TYPE_JSON)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	w.Write(body)
+}
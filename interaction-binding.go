@@ -0,0 +1,183 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+var (
+	snowflakeType      = reflect.TypeFor[Snowflake]()
+	userType           = reflect.TypeFor[User]()
+	memberType         = reflect.TypeFor[Member]()
+	roleType           = reflect.TypeFor[Role]()
+	partialChannelType = reflect.TypeFor[PartialChannel]()
+	attachmentType     = reflect.TypeFor[Attachment]()
+)
+
+// Maps command options into struct fields tagged with `option:"name"`. Add ",required" to the tag
+// (like `option:"reason,required"`) to get error when option is missing. Pointer fields stay nil when option is missing.
+//
+// Supported field types: string, bool, all ints, uints & floats, Snowflake (any ID option),
+// User, Member, Role, PartialChannel and Attachment (resolved from interaction.data.resolved).
+func (itx CommandInteraction) BindOptions(v any) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.Elem().Kind() != reflect.Struct {
+		return errors.New("BindOptions expects pointer to struct")
+	}
+
+	return itx.bindStruct(target.Elem(), false)
+}
+
+// Wraps handler with typed arguments into regular slash command handler. All exported fields of args struct
+// are bound from command options (check CommandInteraction.BindOptions), untagged fields use their snake_case name
+// as option name (like TargetUser -> "target_user"). Use `option:"-"` to skip field. For example:
+//
+//	SlashCommandHandler: tempest.TypedHandler(func(ctx context.Context, itx *tempest.CommandInteraction, args struct {
+//		Target tempest.User `option:"target,required"`
+//		Days   int
+//	}) error {
+//		...
+//	}),
+//
+// It panics if args type is not a struct. Context is the same as Interaction.Context.
+func TypedHandler[T any](fn func(ctx context.Context, itx *CommandInteraction, args T) error) func(itx *CommandInteraction) error {
+	if reflect.TypeFor[T]().Kind() != reflect.Struct {
+		panic("TypedHandler expects struct as handler arguments type, got " + reflect.TypeFor[T]().String())
+	}
+
+	return func(itx *CommandInteraction) error {
+		var args T
+		if err := itx.bindStruct(reflect.ValueOf(&args).Elem(), true); err != nil {
+			return err
+		}
+
+		return fn(itx.Context(), itx, args)
+	}
+}
+
+// Binds options into struct fields. With implicit names, untagged exported fields are bound too.
+func (itx CommandInteraction) bindStruct(target reflect.Value, implicitNames bool) error {
+	targetType := target.Type()
+
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("option")
+		if tag == "-" || (!ok && !implicitNames) {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = snakeCase(field.Name)
+		}
+		required := flags == "required"
+
+		if _, available := itx.GetOptionValue(name); !available {
+			if required {
+				return fmt.Errorf("missing required option %q", name)
+			}
+			continue
+		}
+
+		fieldValue := target.Field(i)
+		if field.Type.Kind() == reflect.Pointer {
+			ptr := reflect.New(field.Type.Elem())
+			if err := itx.bindOption(name, ptr.Elem()); err != nil {
+				return err
+			}
+			fieldValue.Set(ptr)
+			continue
+		}
+
+		if err := itx.bindOption(name, fieldValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Converts Go field name into option name, like "TargetUser" -> "target_user" or "UserID" -> "user_id".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var res strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start new word on lower -> upper change or at the end of acronym ("IDValue" -> "id_value").
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				res.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		res.WriteRune(r)
+	}
+	return res.String()
+}
+
+func (itx CommandInteraction) bindOption(name string, field reflect.Value) error {
+	var value any
+	var ok bool
+
+	switch field.Type() {
+	case snowflakeType:
+		value, ok = itx.GetSnowflake(name)
+	case userType:
+		value, ok = itx.GetUser(name)
+	case memberType:
+		value, ok = itx.GetMember(name)
+	case roleType:
+		value, ok = itx.GetRole(name)
+	case partialChannelType:
+		value, ok = itx.GetChannel(name)
+	case attachmentType:
+		value, ok = itx.GetAttachment(name)
+	default:
+		switch field.Kind() {
+		case reflect.String:
+			value, ok = itx.GetString(name)
+		case reflect.Bool:
+			value, ok = itx.GetBool(name)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var n int64
+			if n, ok = itx.GetInt(name); ok {
+				if field.OverflowInt(n) {
+					return fmt.Errorf("option %q value %d overflows %s", name, n, field.Type())
+				}
+				field.SetInt(n)
+				return nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			var n int64
+			if n, ok = itx.GetInt(name); ok {
+				if n < 0 || field.OverflowUint(uint64(n)) {
+					return fmt.Errorf("option %q value %d overflows %s", name, n, field.Type())
+				}
+				field.SetUint(uint64(n))
+				return nil
+			}
+		case reflect.Float32, reflect.Float64:
+			var n float64
+			if n, ok = itx.GetFloat(name); ok {
+				field.SetFloat(n)
+				return nil
+			}
+		default:
+			return fmt.Errorf("unsupported field type %s for option %q", field.Type(), name)
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("option %q can't be bound to %s", name, field.Type())
+	}
+
+	field.Set(reflect.ValueOf(value).Convert(field.Type()))
+	return nil
+}
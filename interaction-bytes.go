@@ -0,0 +1,109 @@
+package tempest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"net/http"
+)
+
+// Response produced by Client.HandleInteractionBytes (or collected by ResponseBuffer), to be written back by HTTP server of your choice.
+type InteractionHTTPResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Framework agnostic equivalent of Client.ServeHTTP for servers that don't use net/http (like fasthttp or fiber).
+// Pass raw request body with values of "X-Signature-Ed25519" & "X-Signature-Timestamp" headers, then write returned response:
+//
+//	func(ctx *fasthttp.RequestCtx) {
+//		res := client.HandleInteractionBytes(ctx.PostBody(), string(ctx.Request.Header.Peek("X-Signature-Ed25519")), string(ctx.Request.Header.Peek("X-Signature-Timestamp")))
+//		ctx.SetStatusCode(res.StatusCode)
+//		ctx.SetContentType(res.Header.Get("Content-Type"))
+//		ctx.SetBody(res.Body)
+//	}
+//
+// Routers built on net/http (gin, echo, chi) don't need it - Client is already a http.Handler.
+// ClientOptions.AllowedSources aren't checked here as there's no request to read address from, filter sources in your server.
+func (client *Client) HandleInteractionBytes(body []byte, signature string, timestamp string) InteractionHTTPResponse {
+	return client.HandleInteractionBytesWithContext(context.Background(), body, signature, timestamp)
+}
+
+// Same as HandleInteractionBytes, but interaction context (see Interaction.Context) is derived from given one.
+func (client *Client) HandleInteractionBytesWithContext(ctx context.Context, body []byte, signature string, timestamp string) InteractionHTTPResponse {
+	w := NewResponseBuffer()
+
+	if !client.lifecycle.begin() {
+		http.Error(w, "service unavailable - shutting down", http.StatusServiceUnavailable)
+		return w.Response()
+	}
+	defer client.lifecycle.end()
+
+	header := make(http.Header, 2)
+	header.Set("X-Signature-Ed25519", signature)
+	header.Set("X-Signature-Timestamp", timestamp)
+
+	interaction, err := ParseAndVerifyInteraction(header, body, ed25519.PublicKey(client.PublicKey))
+	if errors.Is(err, ErrInvalidSignature) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return w.Response()
+	}
+
+	if err != nil {
+		http.Error(w, "bad request - "+err.Error(), http.StatusBadRequest)
+		return w.Response()
+	}
+
+	parent := context.WithoutCancel(ctx)
+	if client.cancelOnDisconnect {
+		parent = ctx
+	}
+
+	client.logger.Debug("received interaction", "id", interaction.ID, "type", interaction.Type, "guild_id", interaction.GuildID, "guild", client.guildName(interaction.GuildID))
+	client.prepareInteraction(parent, &interaction, body)
+	client.captureInteraction(&interaction, body)
+	if client.forwardInteraction(w, interaction) {
+		return w.Response()
+	}
+
+	client.DispatchInteraction(w, interaction)
+	return w.Response()
+}
+
+// In-memory http.ResponseWriter for running handlers outside of net/http server, like in awslambda package.
+type ResponseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func NewResponseBuffer() *ResponseBuffer {
+	return &ResponseBuffer{header: make(http.Header)}
+}
+
+func (w *ResponseBuffer) Header() http.Header {
+	return w.header
+}
+
+func (w *ResponseBuffer) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *ResponseBuffer) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(data)
+}
+
+// Returns collected response. Status defaults to 200 OK if handler didn't write anything.
+func (w *ResponseBuffer) Response() InteractionHTTPResponse {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return InteractionHTTPResponse{StatusCode: status, Header: w.header, Body: w.body.Bytes()}
+}
@@ -0,0 +1,33 @@
+package tempest
+
+// Records interaction as handled in ClientOptions.DedupStore (under "interaction:<id>" key, kept for interaction token lifetime).
+// Returns false if it was already handled, by this or any other replica sharing the store. Always true without store.
+// It fails open - when store returns error (check CheckedAtomicCacheStore), interaction is handled anyway.
+func (client *Client) claimInteraction(id Snowflake) bool {
+	if client.dedupStore == nil {
+		return true
+	}
+
+	key := "interaction:" + id.String()
+	value := []byte(client.ApplicationID.String())
+
+	if store, ok := client.dedupStore.(CheckedAtomicCacheStore); ok {
+		claimed, err := store.TrySetIfAbsent(key, value, INTERACTION_TOKEN_LIFETIME)
+		if err != nil {
+			client.logger.Warn("failed to check interaction in dedup store, handling it anyway", "id", id, "error", err)
+			return true
+		}
+		return claimed
+	}
+
+	if store, ok := client.dedupStore.(AtomicCacheStore); ok {
+		return store.SetIfAbsent(key, value, INTERACTION_TOKEN_LIFETIME)
+	}
+
+	if _, ok := client.dedupStore.Get(key); ok {
+		return false
+	}
+
+	client.dedupStore.Set(key, value, INTERACTION_TOKEN_LIFETIME)
+	return true
+}
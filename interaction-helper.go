@@ -1,11 +1,112 @@
 package tempest
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 )
 
+const INTERACTION_TOKEN_LIFETIME = time.Minute * 15
+
+// Returns context derived from incoming HTTP request (so it carries its values, like tracing spans) with deadline
+// matching interaction token lifetime. Use it for any work done while handling interaction - all helper methods already do.
+// It's context.Background() for interactions that weren't received through Client.ParseInteraction.
+func (itx Interaction) Context() context.Context {
+	if itx.ctx == nil {
+		return context.Background()
+	}
+	return itx.ctx
+}
+
+// Releases interaction context (see Interaction.Context) together with its deadline timer. Context is owned by handler - call Release
+// (for example with defer) once all work with interaction is done, including follow-ups sent from other goroutines.
+// Otherwise it's kept until deadline set by ClientOptions.InteractionTimeout passes. It's safe to call it more than once.
+func (itx Interaction) Release() {
+	if itx.cancel != nil {
+		itx.cancel()
+	}
+}
+
+// Returns ID of installation owner that authorized interaction - guild ID for GUILD_INSTALL (0 when used in bot DM) or user ID for USER_INSTALL.
+func (itx Interaction) AuthorizingIntegrationOwner(integrationType ApplicationIntegrationType) (Snowflake, bool) {
+	id, ok := itx.IntegrationOwners[integrationType]
+	return id, ok
+}
+
+// Whether interaction was authorized only by user installation. App's bot isn't member of guild or private channel then,
+// so guild & channel REST endpoints will fail - respond through interaction methods only.
+func (itx Interaction) UserInstallOnly() bool {
+	if _, ok := itx.IntegrationOwners[USER_INSTALL]; !ok {
+		return false
+	}
+
+	_, ok := itx.IntegrationOwners[GUILD_INSTALL]
+	return !ok
+}
+
+// Returns active, not consumed entitlement to given SKU (out of ones Discord sent with interaction), if invoking user or guild has it.
+// Use it to gate premium features, for example by replying with PREMIUM_BUTTON_STYLE button when it's missing.
+func (itx Interaction) Entitlement(skuID Snowflake) (Entitlement, bool) {
+	for _, entitlement := range itx.Entitlements {
+		if entitlement.SkuID == skuID && entitlement.Active() && !entitlement.Consumed {
+			return entitlement, true
+		}
+	}
+	return Entitlement{}, false
+}
+
+// Whether invoking user or guild has active entitlement to any of given SKUs.
+func (itx Interaction) HasEntitlement(skuIDs ...Snowflake) bool {
+	for _, id := range skuIDs {
+		if _, ok := itx.Entitlement(id); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns time after which interaction token (used for edits & follow-ups) is no longer valid - 15 minutes after interaction was created.
+func (itx Interaction) ExpiresAt() time.Time {
+	return itx.ID.CreationTimestamp().Add(INTERACTION_TOKEN_LIFETIME)
+}
+
+// Whether interaction token is no longer valid, so interaction can't be edited or followed up anymore.
+func (itx Interaction) Expired() bool {
+	return !time.Now().Before(itx.ExpiresAt())
+}
+
+// Sends request to interaction webhook (edits & follow-ups). Fails early with ErrInteractionExpired once token is no longer valid
+// and wraps 401 responses with it, as Discord reports expired tokens that way.
+func (itx Interaction) webhookRequest(method string, route string, payload any) ([]byte, error) {
+	if itx.Expired() {
+		return nil, ErrInteractionExpired
+	}
+
+	raw, err := itx.Client.Rest.RequestWithContext(itx.Context(), method, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token+route, payload)
+	var restErr *RestError
+	if errors.As(err, &restErr) && (restErr.StatusCode == http.StatusUnauthorized || restErr.Code == INVALID_WEBHOOK_TOKEN_ERROR_CODE) {
+		return nil, fmt.Errorf("%w: %w", ErrInteractionExpired, restErr)
+	}
+
+	return raw, err
+}
+
+// Returns ID of user that triggered interaction, both in guilds & DMs.
+func (itx Interaction) invokerID() Snowflake {
+	if itx.Member != nil && itx.Member.User != nil {
+		return itx.Member.User.ID
+	}
+
+	if itx.User != nil {
+		return itx.User.ID
+	}
+
+	return 0
+}
+
 // Returns value of any type. Check second value to check whether option was provided or not (true if yes).
 func (itx CommandInteraction) GetOptionValue(name string) (any, bool) {
 	options := itx.Data.Options
@@ -22,41 +123,206 @@ func (itx CommandInteraction) GetOptionValue(name string) (any, bool) {
 	return nil, false
 }
 
-// Returns pointer to user if present in interaction.data.resolved. It'll return empty struct if there's no resolved user.
-func (itx CommandInteraction) ResolveUser(id Snowflake) User {
-	return itx.Data.Resolved.Users[id]
+// Returns value of string option. Check second value to check whether option was provided or not (true if yes).
+func (itx CommandInteraction) GetString(name string) (string, bool) {
+	value, ok := itx.GetOptionValue(name)
+	if !ok {
+		return "", false
+	}
+
+	str, ok := value.(string)
+	return str, ok
 }
 
-// Returns pointer to member if present in interaction.data.resolved and binds member.user. It'll return empty struct if there's no resolved member.
-func (itx CommandInteraction) ResolveMember(id Snowflake) Member {
-	member, available := itx.Data.Resolved.Members[id]
-	if available {
-		user := itx.Data.Resolved.Users[id]
-		member.User = &user
-		return member
+// Returns value of string option or fallback value if it wasn't provided.
+func (itx CommandInteraction) GetStringOr(name string, fallback string) string {
+	if value, ok := itx.GetString(name); ok {
+		return value
 	}
-	return Member{}
+	return fallback
 }
 
-// Returns pointer to guild role if present in interaction.data.resolved. It'll return empty struct if there's no resolved role.
-func (itx CommandInteraction) ResolveRole(id Snowflake) (Role, bool) {
+// Returns value of integer option. Works with both float64 & json.Number (ClientOptions.UseJSONNumber) values.
+func (itx CommandInteraction) GetInt(name string) (int64, bool) {
+	value, ok := itx.GetOptionValue(name)
+	if !ok {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	}
+
+	return 0, false
+}
+
+// Returns value of integer option or fallback value if it wasn't provided.
+func (itx CommandInteraction) GetIntOr(name string, fallback int64) int64 {
+	if value, ok := itx.GetInt(name); ok {
+		return value
+	}
+	return fallback
+}
+
+// Returns value of number (double) option. Works with both float64 & json.Number (ClientOptions.UseJSONNumber) values.
+func (itx CommandInteraction) GetFloat(name string) (float64, bool) {
+	value, ok := itx.GetOptionValue(name)
+	if !ok {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		n, err := v.Float64()
+		return n, err == nil
+	}
+
+	return 0, false
+}
+
+// Returns value of number (double) option or fallback value if it wasn't provided.
+func (itx CommandInteraction) GetFloatOr(name string, fallback float64) float64 {
+	if value, ok := itx.GetFloat(name); ok {
+		return value
+	}
+	return fallback
+}
+
+// Returns value of boolean option. Check second value to check whether option was provided or not (true if yes).
+func (itx CommandInteraction) GetBool(name string) (bool, bool) {
+	value, ok := itx.GetOptionValue(name)
+	if !ok {
+		return false, false
+	}
+
+	b, ok := value.(bool)
+	return b, ok
+}
+
+// Returns value of boolean option or fallback value if it wasn't provided.
+func (itx CommandInteraction) GetBoolOr(name string, fallback bool) bool {
+	if value, ok := itx.GetBool(name); ok {
+		return value
+	}
+	return fallback
+}
+
+// Returns ID stored in user, channel, role, mentionable or attachment option.
+func (itx CommandInteraction) GetSnowflake(name string) (Snowflake, bool) {
+	value, ok := itx.GetString(name)
+	if !ok {
+		return 0, false
+	}
+
+	id, err := StringToSnowflake(value)
+	return id, err == nil
+}
+
+// Returns user selected in user (or mentionable) option, resolved from interaction.data.resolved.
+func (itx CommandInteraction) GetUser(name string) (User, bool) {
+	id, ok := itx.GetSnowflake(name)
+	if !ok || itx.Data.Resolved == nil {
+		return User{}, false
+	}
+
+	user, ok := itx.Data.Resolved.Users[id]
+	return user, ok
+}
+
+// Returns member selected in user (or mentionable) option, with member.user bound. It's only available in guilds.
+func (itx CommandInteraction) GetMember(name string) (Member, bool) {
+	id, ok := itx.GetSnowflake(name)
+	if !ok {
+		return Member{}, false
+	}
+
+	return itx.ResolvedMember(id)
+}
+
+// Returns channel selected in channel option, resolved from interaction.data.resolved.
+func (itx CommandInteraction) GetChannel(name string) (PartialChannel, bool) {
+	id, ok := itx.GetSnowflake(name)
+	if !ok || itx.Data.Resolved == nil {
+		return PartialChannel{}, false
+	}
+
+	channel, ok := itx.Data.Resolved.Channels[id]
+	return channel, ok
+}
+
+// Returns role selected in role (or mentionable) option, resolved from interaction.data.resolved.
+func (itx CommandInteraction) GetRole(name string) (Role, bool) {
+	id, ok := itx.GetSnowflake(name)
+	if !ok || itx.Data.Resolved == nil {
+		return Role{}, false
+	}
+
 	role, ok := itx.Data.Resolved.Roles[id]
 	return role, ok
 }
 
-// Returns pointer to partial channel if present in interaction.data.resolved.  It'll return empty struct if there's no resolved partial channel.
+// Returns file uploaded in attachment option, resolved from interaction.data.resolved.
+func (itx CommandInteraction) GetAttachment(name string) (Attachment, bool) {
+	id, ok := itx.GetSnowflake(name)
+	if !ok || itx.Data.Resolved == nil {
+		return Attachment{}, false
+	}
+
+	attachment, ok := itx.Data.Resolved.Attachments[id]
+	return attachment, ok
+}
+
+// Returns user if present in interaction.data.resolved. It'll return empty struct if there's no resolved user.
+//
+// Deprecated: Use CommandInteraction.ResolvedUser, which also reports whether user was resolved.
+func (itx CommandInteraction) ResolveUser(id Snowflake) User {
+	user, _ := itx.ResolvedUser(id)
+	return user
+}
+
+// Returns member if present in interaction.data.resolved and binds member.user. It'll return empty struct if there's no resolved member.
+//
+// Deprecated: Use CommandInteraction.ResolvedMember, which also reports whether member was resolved.
+func (itx CommandInteraction) ResolveMember(id Snowflake) Member {
+	member, _ := itx.ResolvedMember(id)
+	return member
+}
+
+// Returns guild role if present in interaction.data.resolved.
+//
+// Deprecated: Use CommandInteraction.ResolvedRole.
+func (itx CommandInteraction) ResolveRole(id Snowflake) (Role, bool) {
+	return itx.ResolvedRole(id)
+}
+
+// Returns partial channel if present in interaction.data.resolved. It'll return empty struct if there's no resolved partial channel.
+//
+// Deprecated: Use CommandInteraction.ResolvedChannel, which also reports whether channel was resolved.
 func (itx CommandInteraction) ResolveChannel(id Snowflake) PartialChannel {
-	return itx.Data.Resolved.Channels[id]
+	channel, _ := itx.ResolvedChannel(id)
+	return channel
 }
 
-// Returns pointer to message if present in interaction.data.resolved.  It'll return empty struct if there's no resolved message.
+// Returns message if present in interaction.data.resolved. It'll return empty struct if there's no resolved message.
+//
+// Deprecated: Use CommandInteraction.ResolvedMessage, which also reports whether message was resolved.
 func (itx CommandInteraction) ResolveMessage(id Snowflake) Message {
-	return itx.Data.Resolved.Messages[id]
+	message, _ := itx.ResolvedMessage(id)
+	return message
 }
 
-// Returns pointer to attachment if present in interaction.data.resolved.  It'll return empty struct if there's no resolved attachment.
+// Returns attachment if present in interaction.data.resolved. It'll return empty struct if there's no resolved attachment.
+//
+// Deprecated: Use CommandInteraction.ResolvedAttachment, which also reports whether attachment was resolved.
 func (itx CommandInteraction) ResolveAttachment(id Snowflake) Attachment {
-	return itx.Data.Resolved.Attachments[id]
+	attachment, _ := itx.ResolvedAttachment(id)
+	return attachment
 }
 
 // Use to let user/member know that bot is processing command.
@@ -68,7 +334,7 @@ func (itx CommandInteraction) Defer(ephemeral bool) error {
 		flags = EPHEMERAL_MESSAGE_FLAG
 	}
 
-	_, err := itx.Client.Rest.Request(http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
+	_, err := itx.Client.Rest.RequestWithContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
 		Type: DEFERRED_CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
 		Data: &ResponseMessageData{
 			Flags: flags,
@@ -84,7 +350,7 @@ func (itx CommandInteraction) SendReply(reply ResponseMessageData, ephemeral boo
 		reply.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	_, err := itx.Client.Rest.RequestWithFiles(http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
+	_, err := itx.Client.Rest.RequestWithFilesContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
 		Type: CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
 		Data: &reply,
 	}, files)
@@ -99,7 +365,7 @@ func (itx CommandInteraction) SendLinearReply(content string, ephemeral bool) er
 }
 
 func (itx CommandInteraction) SendModal(modal ResponseModalData) error {
-	_, err := itx.Client.Rest.Request(http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseModal{
+	_, err := itx.Client.Rest.RequestWithContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseModal{
 		Type: MODAL_RESPONSE_TYPE,
 		Data: &modal,
 	})
@@ -112,7 +378,7 @@ func (itx CommandInteraction) EditReply(content ResponseMessageData, ephemeral b
 		content.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	_, err := itx.Client.Rest.Request(http.MethodPatch, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token+"/messages/@original", content)
+	_, err := itx.webhookRequest(http.MethodPatch, "/messages/@original", content)
 	return err
 }
 
@@ -123,7 +389,7 @@ func (itx CommandInteraction) EditLinearReply(content string, ephemeral bool) er
 }
 
 func (itx CommandInteraction) DeleteReply() error {
-	_, err := itx.Client.Rest.Request(http.MethodDelete, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token+"/messages/@original", nil)
+	_, err := itx.webhookRequest(http.MethodDelete, "/messages/@original", nil)
 	return err
 }
 
@@ -132,13 +398,13 @@ func (itx CommandInteraction) SendFollowUp(content ResponseMessageData, ephemera
 		content.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	raw, err := itx.Client.Rest.Request(http.MethodPost, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token, content)
+	raw, err := itx.webhookRequest(http.MethodPost, "", content)
 	if err != nil {
 		return Message{}, err
 	}
 
 	res := Message{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return Message{}, errors.New("failed to parse received data from discord")
 	}
@@ -153,7 +419,7 @@ func (itx CommandInteraction) SendLinearFollowUp(content string, ephemeral bool)
 }
 
 func (itx CommandInteraction) EditFollowUp(messageID Snowflake, content ResponseMessageData) error {
-	_, err := itx.Client.Rest.Request(http.MethodPatch, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token+"/messages/"+messageID.String(), content)
+	_, err := itx.webhookRequest(http.MethodPatch, "/messages/"+messageID.String(), content)
 	return err
 }
 
@@ -164,7 +430,7 @@ func (itx CommandInteraction) EditLinearFollowUp(messageID Snowflake, content st
 }
 
 func (itx CommandInteraction) DeleteFollowUp(messageID Snowflake, content ResponseMessage) error {
-	_, err := itx.Client.Rest.Request(http.MethodDelete, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token+"/messages/"+messageID.String(), content)
+	_, err := itx.webhookRequest(http.MethodDelete, "/messages/"+messageID.String(), content)
 	return err
 }
 
@@ -184,7 +450,7 @@ func (itx CommandInteraction) GetFocusedValue() (string, any) {
 
 // Sends to discord info that this component was handled successfully without sending anything more.
 func (itx ComponentInteraction) Acknowledge() error {
-	body, err := json.Marshal(ResponseMessage{
+	body, err := marshalJSON(ResponseMessage{
 		Type: DEFERRED_UPDATE_MESSAGE_RESPONSE_TYPE,
 	})
 
@@ -202,7 +468,7 @@ func (itx ComponentInteraction) AcknowledgeWithMessage(reply ResponseMessageData
 		reply.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	body, err := json.Marshal(ResponseMessage{
+	body, err := marshalJSON(ResponseMessage{
 		Type: CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
 		Data: &reply,
 	})
@@ -223,7 +489,7 @@ func (itx ComponentInteraction) AcknowledgeWithLinearMessage(content string, eph
 }
 
 func (itx ComponentInteraction) AcknowledgeWithModal(modal ResponseModalData) error {
-	body, err := json.Marshal(ResponseModal{
+	body, err := marshalJSON(ResponseModal{
 		Type: MODAL_RESPONSE_TYPE,
 		Data: &modal,
 	})
@@ -258,7 +524,7 @@ func (itx ModalInteraction) GetInputValue(customID string) string {
 
 // Sends to discord info that this component was handled successfully without sending anything more.
 func (itx ModalInteraction) Acknowledge() error {
-	body, err := json.Marshal(ResponseMessage{
+	body, err := marshalJSON(ResponseMessage{
 		Type: DEFERRED_UPDATE_MESSAGE_RESPONSE_TYPE,
 	})
 
@@ -276,7 +542,7 @@ func (itx ModalInteraction) AcknowledgeWithMessage(response ResponseMessageData,
 		response.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	body, err := json.Marshal(ResponseMessage{
+	body, err := marshalJSON(ResponseMessage{
 		Type: CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
 		Data: &response,
 	})
@@ -297,7 +563,7 @@ func (itx ModalInteraction) AcknowledgeWithLinearMessage(content string, ephemer
 }
 
 func (itx ModalInteraction) AcknowledgeWithModal(modal ResponseModalData) error {
-	body, err := json.Marshal(ResponseModal{
+	body, err := marshalJSON(ResponseModal{
 		Type: MODAL_RESPONSE_TYPE,
 		Data: &modal,
 	})
@@ -321,7 +587,7 @@ func (itx ModalInteraction) Defer(ephemeral bool) error {
 		flags = EPHEMERAL_MESSAGE_FLAG
 	}
 
-	_, err := itx.Client.Rest.Request(http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
+	_, err := itx.Client.Rest.RequestWithContext(itx.Context(), http.MethodPost, "/interactions/"+itx.ID.String()+"/"+itx.Token+"/callback", ResponseMessage{
 		Type: DEFERRED_CHANNEL_MESSAGE_WITH_SOURCE_RESPONSE_TYPE,
 		Data: &ResponseMessageData{
 			Flags: flags,
@@ -338,13 +604,13 @@ func (itx ModalInteraction) SendFollowUp(content ResponseMessageData, ephemeral
 		content.Flags |= EPHEMERAL_MESSAGE_FLAG
 	}
 
-	raw, err := itx.Client.Rest.Request(http.MethodPost, "/webhooks/"+itx.ApplicationID.String()+"/"+itx.Token, content)
+	raw, err := itx.webhookRequest(http.MethodPost, "", content)
 	if err != nil {
 		return Message{}, err
 	}
 
 	res := Message{}
-	err = json.Unmarshal(raw, &res)
+	err = unmarshalJSON(raw, &res)
 	if err != nil {
 		return Message{}, errors.New("failed to parse received data from discord")
 	}
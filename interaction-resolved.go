@@ -0,0 +1,170 @@
+package tempest
+
+// Returns resolved user with given ID. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) User(id Snowflake) (User, bool) {
+	if resolved == nil {
+		return User{}, false
+	}
+
+	user, ok := resolved.Users[id]
+	return user, ok
+}
+
+// Returns resolved member with given ID and binds member.user. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) Member(id Snowflake) (Member, bool) {
+	if resolved == nil {
+		return Member{}, false
+	}
+
+	member, ok := resolved.Members[id]
+	if !ok {
+		return Member{}, false
+	}
+
+	if user, ok := resolved.Users[id]; ok {
+		member.User = &user
+	}
+	return member, true
+}
+
+// Returns resolved role with given ID. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) Role(id Snowflake) (Role, bool) {
+	if resolved == nil {
+		return Role{}, false
+	}
+
+	role, ok := resolved.Roles[id]
+	return role, ok
+}
+
+// Returns resolved partial channel with given ID. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) Channel(id Snowflake) (PartialChannel, bool) {
+	if resolved == nil {
+		return PartialChannel{}, false
+	}
+
+	channel, ok := resolved.Channels[id]
+	return channel, ok
+}
+
+// Returns resolved message with given ID. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) Message(id Snowflake) (Message, bool) {
+	if resolved == nil {
+		return Message{}, false
+	}
+
+	message, ok := resolved.Messages[id]
+	return message, ok
+}
+
+// Returns resolved attachment with given ID. Safe to call on nil (interaction without resolved data).
+func (resolved *InteractionDataResolved) Attachment(id Snowflake) (Attachment, bool) {
+	if resolved == nil {
+		return Attachment{}, false
+	}
+
+	attachment, ok := resolved.Attachments[id]
+	return attachment, ok
+}
+
+// Returns user from interaction.data.resolved (user option, user command target or user select value).
+func (itx CommandInteraction) ResolvedUser(id Snowflake) (User, bool) {
+	return itx.Data.Resolved.User(id)
+}
+
+// Returns member from interaction.data.resolved with member.user bound. It's only available in guilds.
+func (itx CommandInteraction) ResolvedMember(id Snowflake) (Member, bool) {
+	member, ok := itx.Data.Resolved.Member(id)
+	if ok {
+		member.GuildID = itx.GuildID
+	}
+	return member, ok
+}
+
+// Returns role from interaction.data.resolved.
+func (itx CommandInteraction) ResolvedRole(id Snowflake) (Role, bool) {
+	return itx.Data.Resolved.Role(id)
+}
+
+// Returns partial channel from interaction.data.resolved.
+func (itx CommandInteraction) ResolvedChannel(id Snowflake) (PartialChannel, bool) {
+	return itx.Data.Resolved.Channel(id)
+}
+
+// Returns message from interaction.data.resolved (message command target).
+func (itx CommandInteraction) ResolvedMessage(id Snowflake) (Message, bool) {
+	return itx.Data.Resolved.Message(id)
+}
+
+// Returns attachment from interaction.data.resolved.
+func (itx CommandInteraction) ResolvedAttachment(id Snowflake) (Attachment, bool) {
+	return itx.Data.Resolved.Attachment(id)
+}
+
+// Returns user from interaction.data.resolved (user or mentionable select value).
+func (itx ComponentInteraction) ResolvedUser(id Snowflake) (User, bool) {
+	return itx.Data.Resolved.User(id)
+}
+
+// Returns member from interaction.data.resolved with member.user bound. It's only available in guilds.
+func (itx ComponentInteraction) ResolvedMember(id Snowflake) (Member, bool) {
+	member, ok := itx.Data.Resolved.Member(id)
+	if ok {
+		member.GuildID = itx.GuildID
+	}
+	return member, ok
+}
+
+// Returns role from interaction.data.resolved (role or mentionable select value).
+func (itx ComponentInteraction) ResolvedRole(id Snowflake) (Role, bool) {
+	return itx.Data.Resolved.Role(id)
+}
+
+// Returns partial channel from interaction.data.resolved (channel select value).
+func (itx ComponentInteraction) ResolvedChannel(id Snowflake) (PartialChannel, bool) {
+	return itx.Data.Resolved.Channel(id)
+}
+
+// Returns message from interaction.data.resolved.
+func (itx ComponentInteraction) ResolvedMessage(id Snowflake) (Message, bool) {
+	return itx.Data.Resolved.Message(id)
+}
+
+// Returns attachment from interaction.data.resolved.
+func (itx ComponentInteraction) ResolvedAttachment(id Snowflake) (Attachment, bool) {
+	return itx.Data.Resolved.Attachment(id)
+}
+
+// Returns user from interaction.data.resolved (user or mentionable select value).
+func (itx ModalInteraction) ResolvedUser(id Snowflake) (User, bool) {
+	return itx.Data.Resolved.User(id)
+}
+
+// Returns member from interaction.data.resolved with member.user bound. It's only available in guilds.
+func (itx ModalInteraction) ResolvedMember(id Snowflake) (Member, bool) {
+	member, ok := itx.Data.Resolved.Member(id)
+	if ok {
+		member.GuildID = itx.GuildID
+	}
+	return member, ok
+}
+
+// Returns role from interaction.data.resolved (role or mentionable select value).
+func (itx ModalInteraction) ResolvedRole(id Snowflake) (Role, bool) {
+	return itx.Data.Resolved.Role(id)
+}
+
+// Returns partial channel from interaction.data.resolved (channel select value).
+func (itx ModalInteraction) ResolvedChannel(id Snowflake) (PartialChannel, bool) {
+	return itx.Data.Resolved.Channel(id)
+}
+
+// Returns message from interaction.data.resolved.
+func (itx ModalInteraction) ResolvedMessage(id Snowflake) (Message, bool) {
+	return itx.Data.Resolved.Message(id)
+}
+
+// Returns attachment from interaction.data.resolved.
+func (itx ModalInteraction) ResolvedAttachment(id Snowflake) (Attachment, bool) {
+	return itx.Data.Resolved.Attachment(id)
+}
@@ -1,6 +1,7 @@
 package tempest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -50,10 +51,11 @@ type Interaction struct {
 
 	// partial channel struct is skipped
 
-	ChannelID Snowflake `json:"channel_id,omitempty"`
-	Member    *Member   `json:"member,omitempty"`
-	User      *User     `json:"user,omitempty"`
-	Token     string    `json:"token"` // Temporary token used for responding to the interaction. It's not the same as bot token.
+	ChannelID Snowflake           `json:"channel_id,omitempty"`
+	Message   *InteractionMessage `json:"message,omitempty"` // Only for component interactions. It's a tiny subset of message that component is attached to.
+	Member    *Member             `json:"member,omitempty"`
+	User      *User               `json:"user,omitempty"`
+	Token     string              `json:"token"` // Temporary token used for responding to the interaction. It's not the same as bot token.
 
 	// version is skipped (docs says it's always 1, read-only property)
 
@@ -62,10 +64,16 @@ type Interaction struct {
 	GuildLocale     string          `json:"guild_locale,omitempty"` // Guild's preferred locale, available if invoked in a guild.
 	Entitlements    []Entitlement   `json:"entitlements,omitzero"`  // For monetized apps, any entitlements for the invoking user, representing access to premium SKUs.
 
-	// authorizing_integration_owners or contexts are pointless as they essentially duplicate data you already have :)
-	// attachment_size_limit is also skipped - appears to have no use anywhere
+	ContextType       InteractionContextType                   `json:"context"`                                 // Where interaction was triggered from (guild, bot DM or other private channel).
+	IntegrationOwners map[ApplicationIntegrationType]Snowflake `json:"authorizing_integration_owners,omitzero"` // Installations that authorized interaction, check Interaction.AuthorizingIntegrationOwner.
 
-	Client *Client `json:"-"`
+	// attachment_size_limit is skipped - appears to have no use anywhere
+
+	Client      *Client         `json:"-"`
+	payloadSize int             // Size of raw request body in bytes.
+	raw         []byte          // Copy of raw payload, only kept when interactions are published.
+	ctx         context.Context // Derived from incoming HTTP request, check Interaction.Context.
+	cancel      context.CancelFunc
 }
 
 // https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object
@@ -74,6 +82,12 @@ type CommandInteraction struct {
 	Data CommandInteractionData `json:"data"`
 }
 
+// Subset of message included in component interactions - enough to identify message without decoding its whole content.
+type InteractionMessage struct {
+	ID    Snowflake    `json:"id"`
+	Flags MessageFlags `json:"flags,omitempty"`
+}
+
 // https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object
 type ComponentInteraction struct {
 	*Interaction
@@ -135,6 +149,7 @@ type ComponentInteractionData struct {
 
 // https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object-modal-submit-data-structure
 type ModalInteractionData struct {
-	CustomID   string            `json:"custom_id"`
-	Components []LayoutComponent `json:"components,omitzero"`
+	CustomID   string                   `json:"custom_id"`
+	Components []LayoutComponent        `json:"components,omitzero"`
+	Resolved   *InteractionDataResolved `json:"resolved,omitempty"` // Present when modal contains user, role, mentionable or channel select.
 }
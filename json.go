@@ -0,0 +1,151 @@
+package tempest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Works like json.Unmarshal but decodes numbers stored in dynamic fields (any, map[string]any) as json.Number instead of float64.
+// Use it when handling raw Discord payloads yourself - float64 can only represent integers up to 2^53 so it silently corrupts 64-bit IDs.
+func UnmarshalWithNumbers(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// Decodes JSON array from reader one element at a time, without loading whole array into memory.
+// Return error from fn to stop early - it'll be returned as is.
+func DecodeJSONArray[T any](r io.Reader, fn func(item T) error) error {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return errors.New("expected JSON array")
+	}
+
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	_, err = decoder.Token() // closing bracket
+	return err
+}
+
+// Top level keys of Discord payloads that tempest skips on purpose (check comments on Interaction struct), ignored by UnmarshalStrict.
+// Nested objects are still checked, so drift in them isn't hidden.
+var skippedFields = map[string]struct{}{
+	"guild":                 {},
+	"channel":               {},
+	"version":               {},
+	"attachment_size_limit": {},
+}
+
+// Returned by UnmarshalStrict when payload has fields that got lost while decoding.
+type UnknownFieldsError struct {
+	Fields []string // Paths of lost fields, like "data.options[0].focused".
+}
+
+func (err *UnknownFieldsError) Error() string {
+	return "payload has fields unknown to decoded struct: " + strings.Join(err.Fields, ", ")
+}
+
+// Works like json.Unmarshal but fails with *UnknownFieldsError if payload has fields that don't fit into v and would be silently dropped.
+// It encodes v back and compares it with original payload, so it also checks nested structs with custom decoding (like components)
+// and catches fields that can't be encoded back. Missing zero values (null, false, 0, "") are fine, just like fields tempest skips on purpose.
+//
+// Use it in tests with real payloads to catch drift between Discord API & tempest structs, check ClientOptions.ReportUnknownFields too.
+func UnmarshalStrict(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var original, decoded any
+	if err := UnmarshalWithNumbers(data, &original); err != nil {
+		return err
+	}
+
+	if err := UnmarshalWithNumbers(encoded, &decoded); err != nil {
+		return err
+	}
+
+	var lost []string
+	collectLostFields("", original, decoded, &lost)
+	if len(lost) > 0 {
+		slices.Sort(lost)
+		return &UnknownFieldsError{Fields: lost}
+	}
+	return nil
+}
+
+func collectLostFields(path string, original any, decoded any, lost *[]string) {
+	switch original := original.(type) {
+	case map[string]any:
+		decodedObject, _ := decoded.(map[string]any)
+		for key, value := range original {
+			if _, ok := skippedFields[key]; ok && path == "" {
+				continue
+			}
+
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+
+			if decodedValue, ok := decodedObject[key]; ok {
+				collectLostFields(fieldPath, value, decodedValue, lost)
+			} else if !isZeroJSON(value) {
+				*lost = append(*lost, fieldPath)
+			}
+		}
+	case []any:
+		decodedArray, _ := decoded.([]any)
+		for i, value := range original {
+			itemPath := path + "[" + strconv.Itoa(i) + "]"
+			if i < len(decodedArray) {
+				collectLostFields(itemPath, value, decodedArray[i], lost)
+			} else if !isZeroJSON(value) {
+				*lost = append(*lost, itemPath)
+			}
+		}
+	}
+}
+
+func isZeroJSON(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case string:
+		return value == ""
+	case json.Number:
+		f, err := value.Float64()
+		return err == nil && f == 0
+	case []any:
+		return len(value) == 0
+	case map[string]any:
+		return len(value) == 0
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package tempest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+type LeaderElectorOptions struct {
+	Store      AtomicCacheStore // Store shared between all instances, like RedisStore.
+	Key        string           // Key used to hold leadership, defaults to "leader". Use different keys to elect separate leaders for separate jobs.
+	InstanceID string           // Unique name of this instance, defaults to hostname + pid + random suffix.
+	TTL        time.Duration    // How long leadership lasts without heartbeat, defaults to 15 seconds. Heartbeat is sent every TTL / 3.
+	OnElected  func()           // Optional function called when this instance becomes leader.
+	OnDemoted  func()           // Optional function called when this instance loses leadership.
+}
+
+// LeaderElector picks single instance out of all app replicas to run singleton jobs (like scheduled command syncs or sweepers),
+// so they don't run once per replica. Leadership is held as key in shared store & renewed with heartbeats - if leader dies,
+// another instance takes over after TTL expires.
+type LeaderElector struct {
+	opt    LeaderElectorOptions
+	leader atomic.Bool
+}
+
+func NewLeaderElector(opt LeaderElectorOptions) *LeaderElector {
+	if opt.Key == "" {
+		opt.Key = "leader"
+	}
+
+	if opt.TTL == 0 {
+		opt.TTL = time.Second * 15
+	}
+
+	if opt.InstanceID == "" {
+		hostname, _ := os.Hostname()
+		suffix := make([]byte, 4)
+		rand.Read(suffix)
+		opt.InstanceID = hostname + "-" + strconv.Itoa(os.Getpid()) + "-" + hex.EncodeToString(suffix)
+	}
+
+	return &LeaderElector{opt: opt}
+}
+
+func (elector *LeaderElector) InstanceID() string {
+	return elector.opt.InstanceID
+}
+
+// Reports whether this instance currently holds leadership.
+func (elector *LeaderElector) IsLeader() bool {
+	return elector.leader.Load()
+}
+
+// Runs function only if this instance is leader. Returns whether it was called.
+func (elector *LeaderElector) RunIfLeader(fn func()) bool {
+	if !elector.IsLeader() {
+		return false
+	}
+
+	fn()
+	return true
+}
+
+// Sends heartbeats until context is cancelled, then gives up leadership (if held) so other instance can take over right away.
+// It blocks so run it in a separate goroutine.
+func (elector *LeaderElector) Run(ctx context.Context) {
+	ticker := time.NewTicker(elector.opt.TTL / 3)
+	defer ticker.Stop()
+
+	for {
+		elector.heartbeat()
+
+		select {
+		case <-ctx.Done():
+			elector.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (elector *LeaderElector) heartbeat() {
+	id := []byte(elector.opt.InstanceID)
+	store := elector.opt.Store
+
+	if store.SetIfAbsent(elector.opt.Key, id, elector.opt.TTL) {
+		elector.setLeader(true)
+		return
+	}
+
+	// Store has no compare-and-set so there's a tiny window between Get & Set
+	// where lease can expire and be taken by another instance. TTL is long enough to make it harmless.
+	current, ok := store.Get(elector.opt.Key)
+	if ok && bytes.Equal(current, id) {
+		store.Set(elector.opt.Key, id, elector.opt.TTL)
+		elector.setLeader(true)
+		return
+	}
+
+	elector.setLeader(false)
+}
+
+func (elector *LeaderElector) release() {
+	if current, ok := elector.opt.Store.Get(elector.opt.Key); ok && bytes.Equal(current, []byte(elector.opt.InstanceID)) {
+		elector.opt.Store.Delete(elector.opt.Key)
+	}
+
+	elector.setLeader(false)
+}
+
+func (elector *LeaderElector) setLeader(leader bool) {
+	if elector.leader.Swap(leader) == leader {
+		return
+	}
+
+	if leader && elector.opt.OnElected != nil {
+		elector.opt.OnElected()
+	} else if !leader && elector.opt.OnDemoted != nil {
+		elector.opt.OnDemoted()
+	}
+}
@@ -0,0 +1,68 @@
+package tempest
+
+import "fmt"
+
+// Translator turns translation keys into text in requested language. Plug your own (i18n library, database, etc.)
+// with ClientOptions.Translator or use MapTranslator for simple, static translations.
+type Translator interface {
+	Translate(language Language, key string, args ...any) string
+}
+
+// Simple Translator based on nested maps: language -> key -> text. Text can contain fmt verbs (like "%s") filled with args.
+// If key is missing in requested language it falls back to Fallback language, and then to key itself.
+type MapTranslator struct {
+	Fallback     Language
+	Translations map[Language]map[string]string
+}
+
+func (translator MapTranslator) Translate(language Language, key string, args ...any) string {
+	text, ok := translator.Translations[language][key]
+	if !ok {
+		text, ok = translator.Translations[translator.Fallback][key]
+		if !ok {
+			text = key
+		}
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
+
+// Returns all translations of key, ready to use as Command.NameLocalizations or Command.DescriptionLocalizations.
+func (translator MapTranslator) Localizations(key string) map[Language]string {
+	res := make(map[Language]string, len(translator.Translations))
+	for language, texts := range translator.Translations {
+		if text, ok := texts[key]; ok {
+			res[language] = text
+		}
+	}
+	return res
+}
+
+// Returns language of invoking user, falling back to guild's preferred language and then to English (US).
+func (itx Interaction) Language() Language {
+	if itx.Locale != "" {
+		return itx.Locale
+	}
+
+	if itx.GuildLocale != "" {
+		return Language(itx.GuildLocale)
+	}
+
+	return ENGLISH_US_LANGUAGE
+}
+
+// Translates key into language of invoking user using ClientOptions.Translator.
+// It returns key as is if client has no translator.
+func (itx Interaction) Translate(key string, args ...any) string {
+	if itx.Client == nil || itx.Client.translator == nil {
+		if len(args) > 0 {
+			return fmt.Sprintf(key, args...)
+		}
+		return key
+	}
+
+	return itx.Client.translator.Translate(itx.Language(), key, args...)
+}
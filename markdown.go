@@ -0,0 +1,137 @@
+package tempest
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type TableAlignment uint8
+
+const (
+	LEFT_TABLE_ALIGNMENT TableAlignment = iota
+	RIGHT_TABLE_ALIGNMENT
+)
+
+type TableOptions struct {
+	Headers        []string         // Optional column headers, separated from rows with divider line.
+	Alignments     []TableAlignment // Optional alignment per column, defaults to LEFT_TABLE_ALIGNMENT.
+	MaxColumnWidth int              // Longer cells are cut with "…". Use 0 for no limit.
+	Limit          int              // Max length of whole code block, defaults to MAX_MESSAGE_CONTENT_LENGTH.
+}
+
+// Wraps code in code block with given language (used for syntax highlighting, can be empty).
+// Code that doesn't fit within limit (use 0 for MAX_MESSAGE_CONTENT_LENGTH) is cut on line boundary
+// and ends with "… (N more lines)" indicator.
+func CodeBlock(language string, code string, limit int) string {
+	// Prevent code from closing block early.
+	code = strings.ReplaceAll(strings.TrimRight(code, "\n"), "```", "`\u200b``")
+	return fitCodeBlock(language, strings.Split(code, "\n"), limit, "lines")
+}
+
+// Renders rows as aligned monospace table inside code block. Rows that don't fit within limit are
+// replaced with "… (N more rows)" indicator.
+func RenderTable(rows [][]string, opt TableOptions) string {
+	columns := len(opt.Headers)
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+
+	cell := func(row []string, column int) string {
+		if column >= len(row) {
+			return ""
+		}
+
+		value := strings.ReplaceAll(row[column], "\n", " ")
+		if opt.MaxColumnWidth > 0 && utf8.RuneCountInString(value) > opt.MaxColumnWidth {
+			value = string([]rune(value)[:max(opt.MaxColumnWidth-1, 0)]) + "…"
+		}
+		return value
+	}
+
+	widths := make([]int, columns)
+	for column := range columns {
+		widths[column] = utf8.RuneCountInString(cell(opt.Headers, column))
+		for _, row := range rows {
+			widths[column] = max(widths[column], utf8.RuneCountInString(cell(row, column)))
+		}
+	}
+
+	render := func(row []string) string {
+		var line strings.Builder
+		for column := range columns {
+			if column > 0 {
+				line.WriteString(" | ")
+			}
+
+			value := cell(row, column)
+			padding := strings.Repeat(" ", widths[column]-utf8.RuneCountInString(value))
+			if column < len(opt.Alignments) && opt.Alignments[column] == RIGHT_TABLE_ALIGNMENT {
+				line.WriteString(padding + value)
+			} else {
+				line.WriteString(value + padding)
+			}
+		}
+		return strings.TrimRight(line.String(), " ")
+	}
+
+	lines := make([]string, 0, len(rows)+2)
+	if len(opt.Headers) > 0 {
+		divider := make([]string, columns)
+		for column := range columns {
+			divider[column] = strings.Repeat("-", widths[column])
+		}
+
+		lines = append(lines, render(opt.Headers), strings.Join(divider, "-+-"))
+	}
+
+	for _, row := range rows {
+		lines = append(lines, render(row))
+	}
+
+	return fitCodeBlock("", lines, opt.Limit, "rows")
+}
+
+// Joins as many lines as fit within limit into code block, followed by indicator of how many were cut.
+func fitCodeBlock(language string, lines []string, limit int, unit string) string {
+	if limit <= 0 {
+		limit = MAX_MESSAGE_CONTENT_LENGTH
+	}
+
+	opening := "```" + language + "\n"
+	closing := "\n```"
+	available := limit - utf8.RuneCountInString(opening) - utf8.RuneCountInString(closing)
+
+	var body strings.Builder
+	length := 0
+	for i, line := range lines {
+		lineLength := utf8.RuneCountInString(line)
+		if i > 0 {
+			lineLength++ // New line character.
+		}
+
+		remaining := len(lines) - i
+		indicator := ""
+		if i < len(lines)-1 {
+			// Always leave room for indicator in case next lines won't fit.
+			indicator = "\n… (" + strconv.Itoa(remaining-1) + " more " + unit + ")"
+		}
+
+		if length+lineLength+utf8.RuneCountInString(indicator) > available {
+			cut := "… (" + strconv.Itoa(remaining) + " more " + unit + ")"
+			if i > 0 {
+				cut = "\n" + cut
+			}
+			body.WriteString(cut)
+			break
+		}
+
+		if i > 0 {
+			body.WriteByte('\n')
+		}
+		body.WriteString(line)
+		length += lineLength
+	}
+
+	return opening + body.String() + closing
+}
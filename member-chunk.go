@@ -0,0 +1,162 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const MAX_MEMBERS_PAGE_SIZE = 1000
+
+// Part of guild member list received from Client.RequestMembers.
+type MemberChunk struct {
+	Index   int // Position of this chunk, starting from 0.
+	Members []Member
+	Err     error // Set on last chunk if fetching failed.
+}
+
+// Returns single page of guild members (up to limit, max 1000), sorted by user ID. Use after = 0 to start from the beginning.
+// Requires GUILD_MEMBERS privileged intent enabled in developer portal.
+//
+// https://discord.com/developers/docs/resources/guild#list-guild-members
+func (client *Client) FetchMembers(guildID Snowflake, limit uint16, after Snowflake) ([]Member, error) {
+	members := make([]Member, 0, min(limit, MAX_MEMBERS_PAGE_SIZE))
+	err := client.StreamMembers(guildID, limit, after, func(member Member) error {
+		members = append(members, member)
+		return nil
+	})
+
+	return members, err
+}
+
+// Iterates over all guild members, fetching them page by page (use pageSize = 0 for max page size) as loop goes.
+// Iteration stops on first error, which is yielded with zero Member:
+//
+//	for member, err := range client.IterateMembers(guildID, 0) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (client *Client) IterateMembers(guildID Snowflake, pageSize uint16) iter.Seq2[Member, error] {
+	if pageSize == 0 || pageSize > MAX_MEMBERS_PAGE_SIZE {
+		pageSize = MAX_MEMBERS_PAGE_SIZE
+	}
+
+	return func(yield func(Member, error) bool) {
+		var after Snowflake
+		for {
+			members, err := client.FetchMembers(guildID, pageSize, after)
+			if err != nil {
+				yield(Member{}, err)
+				return
+			}
+
+			for _, member := range members {
+				if !yield(member, nil) {
+					return
+				}
+			}
+
+			if len(members) < int(pageSize) || members[len(members)-1].User == nil {
+				return
+			}
+			after = members[len(members)-1].User.ID
+		}
+	}
+}
+
+// Returns up to limit (max 1000) members whose username or nickname starts with query.
+//
+// https://discord.com/developers/docs/resources/guild#search-guild-members
+func (client *Client) SearchMembers(guildID Snowflake, query string, limit uint16) ([]Member, error) {
+	route := "/guilds/" + guildID.String() + "/members/search?query=" + url.QueryEscape(query) + "&limit=" + strconv.FormatUint(uint64(limit), 10)
+	raw, err := client.Rest.Request(http.MethodGet, route, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Member
+	if err := unmarshalJSON(raw, &res); err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	for i := range res {
+		res[i].GuildID = guildID
+	}
+
+	return res, nil
+}
+
+// Fetches guild members in chunks (of up to 1000 members) and sends them over returned channel, which is closed once done.
+// With query, it returns members whose username or nickname starts with it (single chunk, up to 1000 members).
+// Without query, it walks whole member list up to limit (use 0 for no limit). Requires GUILD_MEMBERS privileged intent.
+//
+// It's REST equivalent of gateway's "Request Guild Members" - this library has no gateway connection.
+func (client *Client) RequestMembers(ctx context.Context, guildID Snowflake, query string, limit int) <-chan MemberChunk {
+	chunks := make(chan MemberChunk, 1)
+
+	go func() {
+		defer close(chunks)
+
+		send := func(chunk MemberChunk) bool {
+			select {
+			case chunks <- chunk:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if query != "" {
+			size := MAX_MEMBERS_PAGE_SIZE
+			if limit > 0 {
+				size = min(limit, size)
+			}
+
+			members, err := client.SearchMembers(guildID, query, uint16(size))
+			send(MemberChunk{Members: members, Err: err})
+			return
+		}
+
+		var after Snowflake
+		fetched := 0
+		for index := 0; limit <= 0 || fetched < limit; index++ {
+			if ctx.Err() != nil {
+				send(MemberChunk{Index: index, Err: ctx.Err()})
+				return
+			}
+
+			size := MAX_MEMBERS_PAGE_SIZE
+			if limit > 0 {
+				size = min(limit-fetched, size)
+			}
+
+			members, err := client.FetchMembers(guildID, uint16(size), after)
+			if err != nil {
+				send(MemberChunk{Index: index, Members: members, Err: err})
+				return
+			}
+
+			if len(members) == 0 {
+				return
+			}
+
+			fetched += len(members)
+			if !send(MemberChunk{Index: index, Members: members}) || len(members) < size {
+				return
+			}
+
+			if last := members[len(members)-1]; last.User != nil {
+				after = last.User.ID
+			} else {
+				return
+			}
+		}
+	}()
+
+	return chunks
+}
@@ -0,0 +1,76 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Longest timeout Discord accepts, counted from now.
+const MAX_MEMBER_TIMEOUT = time.Hour * 24 * 28
+
+// All fields are optional - only the ones you set will be modified. Use Null to clear nickname, disconnect member from voice or remove timeout.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-member-json-params
+type ModifyMemberPayload struct {
+	Nickname                   Option[string]       `json:"nick,omitzero"`  // Requires MANAGE_NICKNAMES permission.
+	RoleIDs                    []Snowflake          `json:"roles,omitzero"` // Replaces all member roles. Requires MANAGE_ROLES permission.
+	Mute                       *bool                `json:"mute,omitempty"` // Requires MUTE_MEMBERS permission.
+	Deaf                       *bool                `json:"deaf,omitempty"` // Requires DEAFEN_MEMBERS permission.
+	ChannelID                  Option[Snowflake]    `json:"channel_id,omitzero"`
+	CommunicationDisabledUntil Option[ISOTimestamp] `json:"communication_disabled_until,omitzero"` // Requires MODERATE_MEMBERS permission.
+	Flags                      Option[MemberFlags]  `json:"flags,omitzero"`
+}
+
+// Whether member can't currently send messages, react, join voice channels or use commands because of timeout.
+func (member Member) TimedOut() bool {
+	return member.CommunicationDisabledUntil.After(time.Now())
+}
+
+// Replaces member flags and returns updated member. Only BYPASSES_VERIFICATION_MEMBER_FLAG can be changed by bots, other flags are kept by Discord as they are.
+// Requires MANAGE_GUILD, MANAGE_ROLES or MODERATE_MEMBERS (with BAN_MEMBERS or KICK_MEMBERS) permission.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-member
+func (client *Client) ModifyMemberFlags(guildID Snowflake, userID Snowflake, flags MemberFlags) (Member, error) {
+	return client.ModifyMember(guildID, userID, ModifyMemberPayload{Flags: Some(flags)})
+}
+
+// Times out member until given time (up to MAX_MEMBER_TIMEOUT from now). Use zero time to remove timeout.
+// Requires MODERATE_MEMBERS permission and it doesn't work on members with ADMINISTRATOR permission.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-member
+func (client *Client) TimeoutMember(guildID Snowflake, userID Snowflake, until time.Time) (Member, error) {
+	if time.Until(until) > MAX_MEMBER_TIMEOUT {
+		return Member{}, errors.New("member can be timed out for at most 28 days")
+	}
+
+	timeout := Null[ISOTimestamp]()
+	if !until.IsZero() {
+		timeout = Some(NewISOTimestamp(until))
+	}
+
+	return client.ModifyMember(guildID, userID, ModifyMemberPayload{CommunicationDisabledUntil: timeout})
+}
+
+// Modifies guild member and returns updated member. Required permissions depend on modified fields, check ModifyMemberPayload.
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-member
+func (client *Client) ModifyMember(guildID Snowflake, userID Snowflake, payload ModifyMemberPayload) (Member, error) {
+	raw, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String()+"/members/"+userID.String(), payload)
+	if err != nil {
+		return Member{}, err
+	}
+
+	res := Member{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return Member{}, errors.New("failed to parse received data from discord")
+	}
+
+	res.GuildID = guildID
+	if client.Cache != nil {
+		client.Cache.SetMember(guildID, res)
+	}
+
+	return res, nil
+}
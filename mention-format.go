@@ -0,0 +1,131 @@
+package tempest
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// https://discord.com/developers/docs/reference#message-formatting-timestamp-styles
+type TimestampStyle string
+
+const (
+	SHORT_TIME_TIMESTAMP_STYLE      TimestampStyle = "t" // 16:20
+	LONG_TIME_TIMESTAMP_STYLE       TimestampStyle = "T" // 16:20:30
+	SHORT_DATE_TIMESTAMP_STYLE      TimestampStyle = "d" // 20/04/2021
+	LONG_DATE_TIMESTAMP_STYLE       TimestampStyle = "D" // 20 April 2021
+	SHORT_DATE_TIME_TIMESTAMP_STYLE TimestampStyle = "f" // 20 April 2021 16:20 (default style)
+	LONG_DATE_TIME_TIMESTAMP_STYLE  TimestampStyle = "F" // Tuesday, 20 April 2021 16:20
+	RELATIVE_TIMESTAMP_STYLE        TimestampStyle = "R" // 2 months ago
+)
+
+type MentionType uint8
+
+const (
+	USER_MENTION_TYPE MentionType = iota + 1
+	CHANNEL_MENTION_TYPE
+	ROLE_MENTION_TYPE
+	EMOJI_MENTION_TYPE // Custom emoji.
+)
+
+// Mention (or custom emoji) found in message content by ParseMentions.
+type ParsedMention struct {
+	Type     MentionType
+	ID       Snowflake
+	Name     string // Emoji name, empty for other types.
+	Animated bool   // Whether emoji is animated, false for other types.
+	Raw      string // Matched text, like "<@123>".
+	Index    int    // Byte offset of match in content.
+}
+
+var mentionRegex = regexp.MustCompile(`<(@!?|@&|#)(\d{15,21})>|<(a?):(\w{2,32}):(\d{15,21})>`)
+
+func MentionUser(userID Snowflake) string {
+	return "<@" + userID.String() + ">"
+}
+
+func MentionChannel(channelID Snowflake) string {
+	return "<#" + channelID.String() + ">"
+}
+
+func MentionRole(roleID Snowflake) string {
+	return "<@&" + roleID.String() + ">"
+}
+
+// Creates clickable command mention. Use "name subcommand" as name to mention subcommand.
+func MentionCommand(name string, commandID Snowflake) string {
+	return "</" + name + ":" + commandID.String() + ">"
+}
+
+func CustomEmoji(name string, emojiID Snowflake, animated bool) string {
+	if animated {
+		return "<a:" + name + ":" + emojiID.String() + ">"
+	}
+	return "<:" + name + ":" + emojiID.String() + ">"
+}
+
+// Creates timestamp that's displayed in each user's timezone & locale. Use empty style for default one.
+func Timestamp(t time.Time, style TimestampStyle) string {
+	if style == "" {
+		return "<t:" + strconv.FormatInt(t.Unix(), 10) + ">"
+	}
+	return "<t:" + strconv.FormatInt(t.Unix(), 10) + ":" + string(style) + ">"
+}
+
+// Extracts user, channel & role mentions and custom emojis from message content, in order they appear.
+func ParseMentions(content string) []ParsedMention {
+	matches := mentionRegex.FindAllStringSubmatchIndex(content, -1)
+	mentions := make([]ParsedMention, 0, len(matches))
+
+	for _, match := range matches {
+		mention := ParsedMention{Raw: content[match[0]:match[1]], Index: match[0]}
+
+		if match[2] != -1 {
+			switch content[match[2]:match[3]] {
+			case "@", "@!":
+				mention.Type = USER_MENTION_TYPE
+			case "@&":
+				mention.Type = ROLE_MENTION_TYPE
+			default:
+				mention.Type = CHANNEL_MENTION_TYPE
+			}
+
+			id, err := StringToSnowflake(content[match[4]:match[5]])
+			if err != nil {
+				continue
+			}
+			mention.ID = id
+		} else {
+			id, err := StringToSnowflake(content[match[10]:match[11]])
+			if err != nil {
+				continue
+			}
+
+			mention.Type = EMOJI_MENTION_TYPE
+			mention.ID = id
+			mention.Name = content[match[8]:match[9]]
+			mention.Animated = match[7] > match[6]
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
+
+// Returns IDs of all mentions of given type in content, without duplicates.
+func ParseMentionIDs(content string, mentionType MentionType) []Snowflake {
+	var ids []Snowflake
+	seen := make(map[Snowflake]struct{})
+	for _, mention := range ParseMentions(content) {
+		if mention.Type != mentionType {
+			continue
+		}
+
+		if _, ok := seen[mention.ID]; !ok {
+			seen[mention.ID] = struct{}{}
+			ids = append(ids, mention.ID)
+		}
+	}
+	return ids
+}
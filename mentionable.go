@@ -1,10 +1,6 @@
 package tempest
 
-import (
-	"strconv"
-	"strings"
-	"time"
-)
+import "strings"
 
 // https://discord.com/developers/docs/resources/user#user-object-premium-types
 type NitroType uint8
@@ -18,7 +14,7 @@ const (
 
 // https://discord.com/developers/docs/resources/user#avatar-decoration-data-object-avatar-decoration-data-structure
 type AvatarDecoration struct {
-	AssetHash string    `json:"avatar"` // Hash code used to access user's avatar decoration.
+	AssetHash string    `json:"asset"` // Hash code used to access user's avatar decoration.
 	SkuID     Snowflake `json:"sku_id"`
 }
 
@@ -68,15 +64,17 @@ const (
 type User struct {
 	ID                   Snowflake         `json:"id"`
 	Username             string            `json:"username"`
-	GlobalName           string            `json:"global_name,omitempty"`  // User's display name, if it is set. For bots, this is the application name.
-	AvatarHash           string            `json:"avatar,omitempty"`       // Hash code used to access user's profile. Call User.AvatarURL to get direct url.
-	Bot                  bool              `json:"bot,omitempty"`          // Whether it's bot/app account.
-	System               bool              `json:"bool,omitempty"`         // Whether user is Discord System Message account.
-	BannerHash           string            `json:"banner,omitempty"`       // Hash code used to access user's baner. Call User.BannerURL to get direct url.
-	AccentColor          uint32            `json:"accent_color,omitempty"` // User's banner color, encoded as an integer representation of hexadecimal color code.
+	Discriminator        string            `json:"discriminator,omitempty"` // Legacy 4 digit tag, "0" for users that migrated to unique usernames. Bots still have it.
+	GlobalName           string            `json:"global_name,omitempty"`   // User's display name, if it is set. For bots, this is the application name.
+	AvatarHash           string            `json:"avatar,omitempty"`        // Hash code used to access user's profile. Call User.AvatarURL to get direct url.
+	Bot                  bool              `json:"bot,omitempty"`           // Whether it's bot/app account.
+	System               bool              `json:"system,omitempty"`        // Whether user is Discord System Message account.
+	BannerHash           string            `json:"banner,omitempty"`        // Hash code used to access user's baner. Call User.BannerURL to get direct url.
+	AccentColor          uint32            `json:"accent_color,omitempty"`  // User's banner color, encoded as an integer representation of hexadecimal color code.
 	Locale               string            `json:"locale,omitempty"`
 	PremiumType          NitroType         `json:"premium_type,omitempty"`
 	PublicFlags          UserFlags         `json:"public_flags,omitempty"` // (Same as regular, user flags)
+	Flags                UserFlags         `json:"flags,omitempty"`
 	AvatarDecorationData *AvatarDecoration `json:"avatar_decoration_data,omitempty"`
 }
 
@@ -86,28 +84,22 @@ func (user User) Mention() string {
 
 // Returns a direct url to user's avatar. It'll return url to default Discord's avatar if targeted user don't use avatar.
 func (user User) AvatarURL() string {
-	if user.AvatarHash == "" {
-		return DISCORD_CDN_URL + "/embed/avatars/" + strconv.FormatUint(uint64(user.ID>>22)%6, 10) + ".png"
-	}
-
-	if strings.HasPrefix(user.AvatarHash, "a_") {
-		return DISCORD_CDN_URL + "/avatars/" + user.ID.String() + "/" + user.AvatarHash + ".gif"
-	}
+	return UserAvatarURL(user.ID, user.AvatarHash, ImageOptions{})
+}
 
-	return DISCORD_CDN_URL + "/avatars/" + user.ID.String() + "/" + user.AvatarHash
+// Works like User.AvatarURL but requests image in given size (any power of 2 between 16 and 4096).
+func (user User) AvatarURLWithSize(size uint16) string {
+	return UserAvatarURL(user.ID, user.AvatarHash, ImageOptions{Size: size})
 }
 
 // Returns a direct url to user's banner. It'll return empty string if targeted user don't use avatar.
 func (user User) BannerURL() string {
-	if user.BannerHash == "" {
-		return ""
-	}
-
-	if strings.HasPrefix(user.BannerHash, "a_") {
-		return DISCORD_CDN_URL + "/banners/" + user.ID.String() + "/" + user.BannerHash + ".gif"
-	}
+	return UserBannerURL(user.ID, user.BannerHash, ImageOptions{})
+}
 
-	return DISCORD_CDN_URL + "/banners/" + user.ID.String() + "/" + user.BannerHash
+// Works like User.BannerURL but requests image in given size (any power of 2 between 16 and 4096).
+func (user User) BannerURLWithSize(size uint16) string {
+	return UserBannerURL(user.ID, user.BannerHash, ImageOptions{Size: size})
 }
 
 // https://discord.com/developers/docs/resources/guild#guild-member-object-guild-member-flags
@@ -126,6 +118,37 @@ const (
 	DM_SETTINGS_UPSELL_ACKNOWLEDGED_MEMBER_FLAG
 )
 
+// Add allows you to add multiple flags together, producing a new flag set.
+func (f MemberFlags) Add(flags ...MemberFlags) MemberFlags {
+	for _, flag := range flags {
+		f |= flag
+	}
+	return f
+}
+
+// Remove allows you to subtract multiple flags from the set, producing a new flag set.
+func (f MemberFlags) Remove(flags ...MemberFlags) MemberFlags {
+	for _, flag := range flags {
+		f &^= flag
+	}
+	return f
+}
+
+// Has will ensure that the set includes all the flags entered.
+func (f MemberFlags) Has(flags ...MemberFlags) bool {
+	for _, flag := range flags {
+		if (f & flag) != flag {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing will check whether the set is missing any one of the flags.
+func (f MemberFlags) Missing(flags ...MemberFlags) bool {
+	return !f.Has(flags...)
+}
+
 // https://discord.com/developers/docs/resources/guild#guild-member-object-guild-member-structure
 type Member struct {
 	User                       *User             `json:"user,omitempty"`
@@ -133,14 +156,14 @@ type Member struct {
 	GuildAvatarHash            string            `json:"avatar,omitempty"` // Hash code used to access member's custom, guild avatar. Call Member.GuildAvatarURL to get direct url.
 	GuildBannerHash            string            `json:"banner,omitempty"` // Hash code used to access member's custom, guild banner. Call Member.GuildBannerURL to get direct url.
 	RoleIDs                    []Snowflake       `json:"roles"`
-	JoinedAt                   *time.Time        `json:"joined_at"`
-	PremiumSince               *time.Time        `json:"premium_since,omitempty"`
+	JoinedAt                   ISOTimestamp      `json:"joined_at"`
+	PremiumSince               ISOTimestamp      `json:"premium_since,omitzero"`
 	Deaf                       bool              `json:"deaf"`
 	Mute                       bool              `json:"mute"`
 	Flags                      MemberFlags       `json:"flags"`
 	Pending                    bool              `json:"pending,omitempty"`
 	PermissionFlags            PermissionFlags   `json:"permissions,string"`
-	CommunicationDisabledUntil *time.Time        `json:"communication_disabled_until,omitempty"`
+	CommunicationDisabledUntil ISOTimestamp      `json:"communication_disabled_until,omitzero"`
 	AvatarDecorationData       *AvatarDecoration `json:"avatar_decoration_data,omitempty"`
 
 	// It's not part of Member API data struct but tempest Client should always attach it for conveniency.
@@ -158,11 +181,7 @@ func (member Member) GuildAvatarURL() string {
 		panic("member struct is missing guild ID which is required in avatar url method - it appears to be problem of your custom tempest client implementation")
 	}
 
-	if strings.HasPrefix(member.GuildAvatarHash, "a_") {
-		return DISCORD_CDN_URL + "/guilds/" + member.GuildID.String() + "/users/" + member.User.ID.String() + "/avatars/" + member.GuildAvatarHash + ".gif"
-	}
-
-	return DISCORD_CDN_URL + "/guilds/" + member.GuildID.String() + "/users/" + member.User.ID.String() + "/avatars/" + member.GuildAvatarHash
+	return MemberAvatarURL(member.GuildID, member.User.ID, member.GuildAvatarHash, ImageOptions{})
 }
 
 // Returns a direct url to members's guild specific banner.
@@ -176,11 +195,7 @@ func (member Member) GuildBannerURL() string {
 		panic("member struct is missing guild ID which is required in banner url method - it appears to be problem of your custom tempest client implementation")
 	}
 
-	if strings.HasPrefix(member.GuildBannerHash, "a_") {
-		return DISCORD_CDN_URL + "/guilds/" + member.GuildID.String() + "/users/" + member.User.ID.String() + "/banners/" + member.GuildBannerHash + ".gif"
-	}
-
-	return DISCORD_CDN_URL + "/guilds/" + member.GuildID.String() + "/users/" + member.User.ID.String() + "/banners/" + member.GuildBannerHash
+	return MemberBannerURL(member.GuildID, member.User.ID, member.GuildBannerHash, ImageOptions{})
 }
 
 // https://discord.com/developers/docs/topics/permissions#role-object-role-structure
@@ -205,15 +220,7 @@ func (role Role) Mention() string {
 
 // Returns a direct url to role icon. It'll return empty string if there's no custom icon.
 func (role Role) IconURL() string {
-	if role.IconHash == "" {
-		return ""
-	}
-
-	if strings.HasPrefix(role.IconHash, "a_") {
-		return DISCORD_CDN_URL + "/avatars/" + role.ID.String() + "/" + role.IconHash + ".gif"
-	}
-
-	return DISCORD_CDN_URL + "/avatars/" + role.ID.String() + "/" + role.IconHash
+	return RoleIconURL(role.ID, role.IconHash, ImageOptions{})
 }
 
 // https://discord.com/developers/docs/topics/permissions#role-object-role-tags-structure
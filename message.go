@@ -1,9 +1,6 @@
 package tempest
 
-import (
-	"strconv"
-	"time"
-)
+import "strconv"
 
 // https://discord.com/developers/docs/resources/message#message-object-message-flags
 type MessageFlags BitSet
@@ -27,6 +24,37 @@ const (
 	IS_COMPONENTS_V2_MESSAGE_FLAG // When used, regular content, embeds, poll & stickers fields will be ignored.
 )
 
+// Add allows you to add multiple flags together, producing a new flag set.
+func (f MessageFlags) Add(flags ...MessageFlags) MessageFlags {
+	for _, flag := range flags {
+		f |= flag
+	}
+	return f
+}
+
+// Remove allows you to subtract multiple flags from the set, producing a new flag set.
+func (f MessageFlags) Remove(flags ...MessageFlags) MessageFlags {
+	for _, flag := range flags {
+		f &^= flag
+	}
+	return f
+}
+
+// Has will ensure that the set includes all the flags entered.
+func (f MessageFlags) Has(flags ...MessageFlags) bool {
+	for _, flag := range flags {
+		if (f & flag) != flag {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing will check whether the set is missing any one of the flags.
+func (f MessageFlags) Missing(flags ...MessageFlags) bool {
+	return !f.Has(flags...)
+}
+
 // https://discord.com/developers/docs/resources/channel#channel-object-channel-types
 type ChannelType uint8
 
@@ -142,6 +170,7 @@ type Emoji struct {
 
 // https://discord.com/developers/docs/resources/channel#embed-object-embed-structure (always rich embed type)
 type Embed struct {
+	Type        string          `json:"type,omitempty"` // Always "rich" for embeds sent by apps.
 	Title       string          `json:"title,omitempty"`
 	URL         string          `json:"url,omitempty"`
 	Author      *EmbedAuthor    `json:"author,omitempty"`
@@ -153,7 +182,7 @@ type Embed struct {
 	Image       *EmbedImage     `json:"image,omitempty"`
 	Video       *EmbedVideo     `json:"video,omitempty"`
 	Provider    *EmbedProvider  `json:"provider,omitempty"`
-	Timestamp   *time.Time      `json:"timestamp,omitempty"`
+	Timestamp   ISOTimestamp    `json:"timestamp,omitzero"`
 }
 
 // https://discord.com/developers/docs/resources/channel#embed-object-embed-author-structure
@@ -214,8 +243,8 @@ type Message struct {
 	ChannelID         Snowflake           `json:"channel_id"`
 	Author            *User               `json:"author,omitempty"`
 	Content           string              `json:"content,omitempty"`
-	Timestamp         *time.Time          `json:"timestamp"`
-	EditedTimestamp   *time.Time          `json:"edited_timestamp,omitempty"`
+	Timestamp         ISOTimestamp        `json:"timestamp"`
+	EditedTimestamp   ISOTimestamp        `json:"edited_timestamp,omitzero"`
 	TTS               bool                `json:"tts"`
 	MentionEveryone   bool                `json:"mention_everyone"`
 	Mentions          []User              `json:"mentions"`
@@ -229,11 +258,12 @@ type Message struct {
 	Type              BitSet              `json:"type,omitempty"` // https://discord.com/developers/docs/resources/channel#message-object-message-types
 	ApplicationID     Snowflake           `json:"application_id,omitempty"`
 	MessageReference  *MessageReference   `json:"message_reference,omitempty"`
-	Flags             uint64              `json:"flags,omitempty"`
+	Flags             MessageFlags        `json:"flags,omitempty"`
 	ReferencedMessage *Message            `json:"referenced_message,omitempty"`
 	Interaction       *MessageInteraction `json:"interaction,omitempty"`
 	Components        []LayoutComponent   `json:"components,omitzero"`
 	StickerItems      []StickerItem       `json:"sticker_items,omitzero"`
+	AllowedMentions   *AllowedMentions    `json:"allowed_mentions,omitempty"` // Only used when sending or editing message, Discord never returns it.
 }
 
 // https://discord.com/developers/docs/resources/channel#message-reference-object-message-reference-structure
@@ -0,0 +1,30 @@
+package tempest
+
+import "time"
+
+// Metrics receives measurements from Rest client & interaction dispatcher so app can be monitored (for example with Prometheus or OpenTelemetry).
+// Implementation has to be safe for concurrent use. Check NoopMetrics for default one.
+type Metrics interface {
+	// Called after each response from Discord API. Route is generic (IDs are replaced with placeholders like "/channels/:id/messages").
+	// Status code is 0 if request failed before receiving any response.
+	ObserveRequest(method, route string, statusCode int, duration time.Duration)
+
+	// Called whenever Rest client had to wait because of exhausted rate limit.
+	ObserveRateLimitWait(route string, wait time.Duration)
+
+	// Called after each handled interaction. Name is either command name or component/modal custom ID.
+	ObserveInteraction(interactionType InteractionType, name string, duration time.Duration)
+}
+
+// Optional extension of Metrics. If provided Metrics implements it, client also reports size of each received interaction payload.
+type PayloadMetrics interface {
+	// Called after each handled interaction with size (in bytes) of raw request body.
+	ObservePayloadSize(interactionType InteractionType, name string, size int)
+}
+
+// Metrics implementation that ignores all measurements. It's used by default.
+type NoopMetrics struct{}
+
+func (NoopMetrics) ObserveRequest(string, string, int, time.Duration)         {}
+func (NoopMetrics) ObserveRateLimitWait(string, time.Duration)                {}
+func (NoopMetrics) ObserveInteraction(InteractionType, string, time.Duration) {}
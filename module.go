@@ -0,0 +1,132 @@
+package tempest
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Module groups related commands, component handlers & background tasks into self-contained package, see Client.AddModule.
+// Implement it on pointer type - loaded modules are compared by identity.
+type Module interface {
+	Commands() []Command                                   // Commands to register. Subcommands are passed with "parent@subcommand" names.
+	Components() map[string]func(itx ComponentInteraction) // Static component handlers, keyed by custom ID.
+	OnLoad(client *Client) error                           // Called once commands & components are registered. Start background tasks here (but don't add or remove modules).
+	OnUnload()                                             // Called on Client.RemoveModule & Client.Shutdown. Stop background tasks here.
+}
+
+type moduleRegistry struct {
+	mu      sync.Mutex
+	modules []Module
+}
+
+// Registers module's commands & components and calls its OnLoad. If any step fails, everything registered so far is rolled back.
+// Commands are only registered locally - call Client.SyncCommandsWithDiscord (or use Client.ReplaceCommands) to publish them.
+func (client *Client) AddModule(module Module) error {
+	client.modules.mu.Lock()
+	defer client.modules.mu.Unlock()
+
+	if slices.Contains(client.modules.modules, module) {
+		return errors.New("module is already loaded")
+	}
+
+	commands := module.Commands()
+	components := module.Components()
+
+	var registered []string
+	var registeredComponents []string
+	rollback := func() {
+		for _, name := range slices.Backward(registered) {
+			client.UnregisterCommand(name)
+		}
+		for _, customID := range registeredComponents {
+			client.staticComponents.Delete(customID)
+		}
+	}
+
+	for _, cmd := range commands {
+		if strings.Contains(cmd.Name, "@") {
+			continue
+		}
+
+		if err := client.RegisterCommand(cmd); err != nil {
+			rollback()
+			return err
+		}
+		registered = append(registered, cmd.Name)
+	}
+
+	for _, cmd := range commands {
+		parent, name, ok := strings.Cut(cmd.Name, "@")
+		if !ok {
+			continue
+		}
+
+		cmd.Name = name
+		if err := client.RegisterSubCommand(cmd, parent); err != nil {
+			rollback()
+			return err
+		}
+		registered = append(registered, parent+"@"+name)
+	}
+
+	for customID, fn := range components {
+		if err := client.RegisterComponent([]string{customID}, fn); err != nil {
+			rollback()
+			return err
+		}
+		registeredComponents = append(registeredComponents, customID)
+	}
+
+	if err := module.OnLoad(client); err != nil {
+		rollback()
+		return err
+	}
+
+	client.modules.modules = append(client.modules.modules, module)
+	return nil
+}
+
+// Calls module's OnUnload and removes its commands & components from registry. Returns false if module wasn't loaded.
+func (client *Client) RemoveModule(module Module) bool {
+	client.modules.mu.Lock()
+	defer client.modules.mu.Unlock()
+
+	index := slices.Index(client.modules.modules, module)
+	if index == -1 {
+		return false
+	}
+
+	client.modules.modules = slices.Delete(client.modules.modules, index, index+1)
+	module.OnUnload()
+
+	for _, cmd := range module.Commands() {
+		client.UnregisterCommand(cmd.Name)
+	}
+
+	for customID := range module.Components() {
+		client.staticComponents.Delete(customID)
+	}
+
+	return true
+}
+
+// Returns currently loaded modules, in order of loading.
+func (client *Client) Modules() []Module {
+	client.modules.mu.Lock()
+	defer client.modules.mu.Unlock()
+	return slices.Clone(client.modules.modules)
+}
+
+// Unloads all modules, in reverse order of loading.
+func (client *Client) unloadModules() {
+	client.modules.mu.Lock()
+	modules := client.modules.modules
+	client.modules.modules = nil
+	client.modules.mu.Unlock()
+
+	for _, module := range slices.Backward(modules) {
+		module.OnUnload()
+	}
+}
@@ -0,0 +1,101 @@
+package tempest
+
+import "time"
+
+// NameCache keeps lightweight ID -> name mappings of guilds & channels, so log lines & error messages can include
+// readable names without extra API calls. It's filled from REST responses (like Client.FetchGuild) as they come.
+type NameCache struct {
+	ttl      time.Duration
+	guilds   *SharedMap[Snowflake, nameCacheEntry]
+	channels *SharedMap[Snowflake, nameCacheEntry]
+}
+
+type nameCacheEntry struct {
+	name      string
+	expiresAt time.Time // Zero if entry never expires.
+}
+
+// Creates name cache where each entry lives for ttl (use 0 to keep entries forever).
+func NewNameCache(ttl time.Duration) *NameCache {
+	return &NameCache{
+		ttl:      ttl,
+		guilds:   NewSharedMap[Snowflake, nameCacheEntry](),
+		channels: NewSharedMap[Snowflake, nameCacheEntry](),
+	}
+}
+
+func (cache *NameCache) GuildName(guildID Snowflake) (string, bool) {
+	return cache.get(cache.guilds, guildID)
+}
+
+func (cache *NameCache) ChannelName(channelID Snowflake) (string, bool) {
+	return cache.get(cache.channels, channelID)
+}
+
+func (cache *NameCache) SetGuildName(guildID Snowflake, name string) {
+	cache.set(cache.guilds, guildID, name)
+}
+
+func (cache *NameCache) SetChannelName(channelID Snowflake, name string) {
+	cache.set(cache.channels, channelID, name)
+}
+
+// Returns "name (id)" if guild name is known or just ID otherwise.
+func (cache *NameCache) FormatGuild(guildID Snowflake) string {
+	if name, ok := cache.GuildName(guildID); ok {
+		return name + " (" + guildID.String() + ")"
+	}
+	return guildID.String()
+}
+
+// Returns "#name (id)" if channel name is known or just ID otherwise.
+func (cache *NameCache) FormatChannel(channelID Snowflake) string {
+	if name, ok := cache.ChannelName(channelID); ok {
+		return "#" + name + " (" + channelID.String() + ")"
+	}
+	return channelID.String()
+}
+
+// Removes expired entries. Expired entries are never returned anyway, call it periodically to free memory.
+func (cache *NameCache) Sweep() {
+	now := time.Now()
+	for _, names := range []*SharedMap[Snowflake, nameCacheEntry]{cache.guilds, cache.channels} {
+		names.mu.Lock()
+		for id, entry := range names.cache {
+			if !entry.expiresAt.IsZero() && now.After(entry.expiresAt) {
+				delete(names.cache, id)
+			}
+		}
+		names.mu.Unlock()
+	}
+}
+
+func (cache *NameCache) get(names *SharedMap[Snowflake, nameCacheEntry], id Snowflake) (string, bool) {
+	entry, ok := names.Get(id)
+	if !ok || (!entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt)) {
+		return "", false
+	}
+	return entry.name, true
+}
+
+func (cache *NameCache) set(names *SharedMap[Snowflake, nameCacheEntry], id Snowflake, name string) {
+	if name == "" {
+		return
+	}
+
+	entry := nameCacheEntry{name: name}
+	if cache.ttl > 0 {
+		entry.expiresAt = time.Now().Add(cache.ttl)
+	}
+	names.Set(id, entry)
+}
+
+// Returns cached guild name for logs, or empty string if it's unknown.
+func (client *Client) guildName(guildID Snowflake) string {
+	if client.Names == nil || guildID == 0 {
+		return ""
+	}
+
+	name, _ := client.Names.GuildName(guildID)
+	return name
+}
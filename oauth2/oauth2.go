@@ -0,0 +1,186 @@
+// Package oauth2 implements Discord's OAuth2 authorization code flow and calls made on behalf of authorized users,
+// which is what most bot dashboards need.
+//
+// https://discord.com/developers/docs/topics/oauth2
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+const (
+	AUTHORIZE_URL    = "https://discord.com/oauth2/authorize"
+	TOKEN_URL        = tempest.DISCORD_API_URL + "/oauth2/token"
+	REVOKE_TOKEN_URL = tempest.DISCORD_API_URL + "/oauth2/token/revoke"
+)
+
+// https://discord.com/developers/docs/topics/oauth2#shared-resources-oauth2-scopes
+type Scope string
+
+const (
+	IDENTIFY_SCOPE                                 Scope = "identify"
+	EMAIL_SCOPE                                    Scope = "email"
+	GUILDS_SCOPE                                   Scope = "guilds"
+	GUILDS_JOIN_SCOPE                              Scope = "guilds.join"
+	GUILDS_MEMBERS_READ_SCOPE                      Scope = "guilds.members.read"
+	CONNECTIONS_SCOPE                              Scope = "connections"
+	BOT_SCOPE                                      Scope = "bot"
+	APPLICATIONS_COMMANDS_SCOPE                    Scope = "applications.commands"
+	ROLE_CONNECTIONS_WRITE_SCOPE                   Scope = "role_connections.write"
+	APPLICATIONS_ENTITLEMENTS_SCOPE                Scope = "applications.entitlements"
+	APPLICATIONS_COMMANDS_PERMISSIONS_UPDATE_SCOPE Scope = "applications.commands.permissions.update" // Required by tempest.Client.EditCommandPermissions.
+)
+
+type Config struct {
+	ClientID     tempest.Snowflake // Application ID.
+	ClientSecret string
+	RedirectURI  string       // Has to match one of redirects registered in Developer Portal.
+	Scopes       []Scope      // Scopes requested in authorize URL.
+	HTTPClient   *http.Client // Optional client used for token requests, defaults to http.DefaultClient.
+}
+
+// https://discord.com/developers/docs/topics/oauth2#authorization-code-grant-access-token-response
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	ExpiresIn    int64     `json:"expires_in"` // Seconds until access token expires.
+	RefreshToken string    `json:"refresh_token"`
+	Scope        string    `json:"scope"` // Space separated list of granted scopes.
+	ExpiresAt    time.Time `json:"-"`     // Calculated when token is received.
+}
+
+// Whether access token already expired (or expires within next minute) and should be refreshed.
+func (token Token) Expired() bool {
+	return !token.ExpiresAt.IsZero() && time.Now().Add(time.Minute).After(token.ExpiresAt)
+}
+
+// Error returned by token endpoint.
+type Error struct {
+	Status      int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (err Error) Error() string {
+	if err.Description != "" {
+		return fmt.Sprintf("oauth2 error %d (%s): %s", err.Status, err.Code, err.Description)
+	}
+	return fmt.Sprintf("oauth2 error %d (%s)", err.Status, err.Code)
+}
+
+// Creates URL to which user should be redirected to authorize application.
+// State should be random, per-session value that you later compare against state received in redirect.
+func (config Config) AuthorizeURL(state string) string {
+	scopes := make([]string, len(config.Scopes))
+	for i, scope := range config.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", config.ClientID.String())
+	query.Set("scope", strings.Join(scopes, " "))
+	if config.RedirectURI != "" {
+		query.Set("redirect_uri", config.RedirectURI)
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+
+	return AUTHORIZE_URL + "?" + query.Encode()
+}
+
+// Exchanges authorization code (received in redirect) for access token.
+func (config Config) Exchange(ctx context.Context, code string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", config.RedirectURI)
+	return config.requestToken(ctx, form)
+}
+
+// Exchanges refresh token for new access token. Previous tokens are no longer valid after that.
+func (config Config) Refresh(ctx context.Context, refreshToken string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	return config.requestToken(ctx, form)
+}
+
+// Revokes access or refresh token. Revoking either of them revokes the whole authorization.
+func (config Config) Revoke(ctx context.Context, token string) error {
+	form := url.Values{}
+	form.Set("token", token)
+
+	res, err := config.post(ctx, REVOKE_TOKEN_URL, form)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return readError(res)
+	}
+
+	io.Copy(io.Discard, res.Body)
+	return nil
+}
+
+func (config Config) requestToken(ctx context.Context, form url.Values) (Token, error) {
+	res, err := config.post(ctx, TOKEN_URL, form)
+	if err != nil {
+		return Token{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Token{}, readError(res)
+	}
+
+	var token Token
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return Token{}, errors.New("failed to parse received data from discord")
+	}
+
+	if token.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+func (config Config) post(ctx context.Context, endpoint string, form url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(config.ClientID.String(), config.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", tempest.USER_AGENT)
+
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return client.Do(req)
+}
+
+func readError(res *http.Response) error {
+	err := Error{Status: res.StatusCode}
+	if json.NewDecoder(res.Body).Decode(&err) != nil || err.Code == "" {
+		err.Code = http.StatusText(res.StatusCode)
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+// https://discord.com/developers/docs/resources/user#get-current-user-guilds-example-partial-guild
+type PartialGuild struct {
+	ID          tempest.Snowflake       `json:"id"`
+	Name        string                  `json:"name"`
+	IconHash    string                  `json:"icon,omitempty"`
+	BannerHash  string                  `json:"banner,omitempty"`
+	Owner       bool                    `json:"owner"`              // Whether user owns this guild.
+	Permissions tempest.PermissionFlags `json:"permissions,string"` // User's base permissions in this guild (without channel overwrites).
+	Features    []string                `json:"features"`
+	MemberCount uint32                  `json:"approximate_member_count,omitempty"`
+}
+
+// Whether user can manage this guild (is owner, administrator or has manage guild permission).
+// That's what most dashboards use to decide which guilds to list.
+func (guild PartialGuild) CanManage() bool {
+	return guild.Owner || guild.Permissions.Has(tempest.ADMINISTRATOR_PERMISSION_FLAG) || guild.Permissions.Has(tempest.MANAGE_GUILD_PERMISSION_FLAG)
+}
+
+// https://discord.com/developers/docs/resources/user#connection-object
+type Connection struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"` // Service name, like "github", "steam" or "twitch".
+	Revoked      bool   `json:"revoked,omitempty"`
+	Verified     bool   `json:"verified"`
+	FriendSync   bool   `json:"friend_sync"`
+	ShowActivity bool   `json:"show_activity"`
+	TwoWayLink   bool   `json:"two_way_link"`
+	Visibility   uint8  `json:"visibility"` // 0 = only user, 1 = everyone.
+}
+
+// Session calls Discord API on behalf of user who authorized application.
+type Session struct {
+	Rest *tempest.Rest // Rest authorized with user's bearer token.
+}
+
+func NewSession(token Token) *Session {
+	return &Session{Rest: tempest.NewBearerRest(token.AccessToken)}
+}
+
+// Returns authorized user. Requires "identify" scope ("email" scope to include email).
+func (session *Session) CurrentUser(ctx context.Context) (tempest.User, error) {
+	var user tempest.User
+	err := session.get(ctx, "/users/@me", &user)
+	return user, err
+}
+
+// Returns guilds user is member of. Requires "guilds" scope.
+func (session *Session) CurrentUserGuilds(ctx context.Context) ([]PartialGuild, error) {
+	var guilds []PartialGuild
+	err := session.get(ctx, "/users/@me/guilds?with_counts=true", &guilds)
+	return guilds, err
+}
+
+// Returns user's connected accounts. Requires "connections" scope.
+func (session *Session) CurrentUserConnections(ctx context.Context) ([]Connection, error) {
+	var connections []Connection
+	err := session.get(ctx, "/users/@me/connections", &connections)
+	return connections, err
+}
+
+func (session *Session) get(ctx context.Context, route string, v any) error {
+	raw, err := session.Rest.RequestWithContext(ctx, http.MethodGet, route, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return errors.New("failed to parse received data from discord")
+	}
+
+	return nil
+}
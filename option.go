@@ -0,0 +1,69 @@
+package tempest
+
+import "bytes"
+
+// Optional, nullable value for edit payloads, where Discord treats missing field as "don't change" and null as "clear it".
+// Zero value is unset - fields using it need "omitzero" tag, so unset options are skipped:
+//
+//	tempest.ModifyChannelPayload{Topic: tempest.Null[string]()}      // Removes channel topic.
+//	tempest.ModifyChannelPayload{Topic: tempest.Some("Read #rules")} // Sets new topic.
+//	tempest.ModifyChannelPayload{}                                   // Leaves topic unchanged.
+type Option[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Returns option set to given value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, set: true}
+}
+
+// Returns option set to null, which clears field on Discord side.
+func Null[T any]() Option[T] {
+	return Option[T]{set: true, null: true}
+}
+
+// Returns value and whether option holds one (it's neither unset nor null).
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// Returns value or fallback if option is unset or null.
+func (o Option[T]) Or(fallback T) T {
+	if !o.set || o.null {
+		return fallback
+	}
+	return o.value
+}
+
+func (o Option[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// Reports whether option is unset, so "omitzero" skips it.
+func (o Option[T]) IsZero() bool {
+	return !o.set
+}
+
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.null {
+		return []byte("null"), nil
+	}
+	return marshalJSON(o.value)
+}
+
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+
+	var value T
+	if err := unmarshalJSON(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
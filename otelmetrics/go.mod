@@ -0,0 +1,11 @@
+module github.com/amatsagu/tempest/otelmetrics
+
+go 1.24.6
+
+replace github.com/amatsagu/tempest => ../
+
+require (
+	github.com/amatsagu/tempest v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.35.0
+	go.opentelemetry.io/otel/metric v1.35.0
+)
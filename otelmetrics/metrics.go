@@ -0,0 +1,91 @@
+// Package otelmetrics provides OpenTelemetry implementation of tempest.Metrics interface.
+// It lives in separate module so core library stays free of external dependencies.
+package otelmetrics
+
+import (
+	"context"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Metrics records tempest measurements with OpenTelemetry meter. Create it with New function.
+type Metrics struct {
+	requests       metric.Int64Counter
+	requestTime    metric.Float64Histogram
+	rateLimitWaits metric.Float64Histogram
+	interactions   metric.Float64Histogram
+	payloadSizes   metric.Int64Histogram
+}
+
+var (
+	_ tempest.Metrics        = (*Metrics)(nil)
+	_ tempest.PayloadMetrics = (*Metrics)(nil)
+)
+
+// Creates all instruments on provided meter. Pass result as tempest.ClientOptions.Metrics.
+func New(meter metric.Meter) (*Metrics, error) {
+	requests, err := meter.Int64Counter("tempest.rest.requests", metric.WithDescription("Number of requests sent to Discord API."))
+	if err != nil {
+		return nil, err
+	}
+
+	requestTime, err := meter.Float64Histogram("tempest.rest.request.duration", metric.WithUnit("s"), metric.WithDescription("Time it took Discord API to respond."))
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitWaits, err := meter.Float64Histogram("tempest.rest.rate_limit.wait", metric.WithUnit("s"), metric.WithDescription("Time spent waiting for exhausted rate limits."))
+	if err != nil {
+		return nil, err
+	}
+
+	interactions, err := meter.Float64Histogram("tempest.interaction.duration", metric.WithUnit("s"), metric.WithDescription("Time it took to handle interaction."))
+	if err != nil {
+		return nil, err
+	}
+
+	payloadSizes, err := meter.Int64Histogram("tempest.interaction.payload.size", metric.WithUnit("By"), metric.WithDescription("Size of received interaction payload."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		requests:       requests,
+		requestTime:    requestTime,
+		rateLimitWaits: rateLimitWaits,
+		interactions:   interactions,
+		payloadSizes:   payloadSizes,
+	}, nil
+}
+
+func (m *Metrics) ObserveRequest(method, route string, statusCode int, duration time.Duration) {
+	attrs := metric.WithAttributes(
+		attribute.String("http.method", method),
+		attribute.String("http.route", route),
+		attribute.Int("http.status_code", statusCode),
+	)
+
+	m.requests.Add(context.Background(), 1, attrs)
+	m.requestTime.Record(context.Background(), duration.Seconds(), attrs)
+}
+
+func (m *Metrics) ObserveRateLimitWait(route string, wait time.Duration) {
+	m.rateLimitWaits.Record(context.Background(), wait.Seconds(), metric.WithAttributes(attribute.String("http.route", route)))
+}
+
+func (m *Metrics) ObserveInteraction(interactionType tempest.InteractionType, name string, duration time.Duration) {
+	m.interactions.Record(context.Background(), duration.Seconds(), metric.WithAttributes(
+		attribute.Int("interaction.type", int(interactionType)),
+		attribute.String("interaction.name", name),
+	))
+}
+
+func (m *Metrics) ObservePayloadSize(interactionType tempest.InteractionType, name string, size int) {
+	m.payloadSizes.Record(context.Background(), int64(size), metric.WithAttributes(
+		attribute.Int("interaction.type", int(interactionType)),
+		attribute.String("interaction.name", name),
+	))
+}
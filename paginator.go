@@ -0,0 +1,161 @@
+package tempest
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	PAGINATOR_FIRST_CUSTOM_ID = "tempest-paginator-first"
+	PAGINATOR_PREV_CUSTOM_ID  = "tempest-paginator-prev"
+	PAGINATOR_PAGE_CUSTOM_ID  = "tempest-paginator-page"
+	PAGINATOR_NEXT_CUSTOM_ID  = "tempest-paginator-next"
+	PAGINATOR_LAST_CUSTOM_ID  = "tempest-paginator-last"
+)
+
+type PaginatorOptions struct {
+	Pages        []Embed                       // Static pages. Leave empty if you use PageProvider.
+	PageProvider func(page int) (Embed, error) // Optional function that creates page on demand (page starts from 0). Requires PageCount.
+	PageCount    int                           // Number of pages available through PageProvider.
+	Ephemeral    bool                          // Whether paginated message should be visible only to invoking user.
+	AllowOthers  bool                          // Whether other users can switch pages too. By default only invoking user can.
+	Timeout      time.Duration                 // How long paginator waits for next click before disabling buttons, defaults to 5 minutes.
+}
+
+// Paginator sends embed with first/prev/next/last buttons and handles switching pages until it times out.
+// It uses message collector so there's no need to register any components.
+type Paginator struct {
+	opt PaginatorOptions
+}
+
+func NewPaginator(opt PaginatorOptions) *Paginator {
+	if len(opt.Pages) > 0 {
+		opt.PageCount = len(opt.Pages)
+	}
+
+	if opt.Timeout == 0 {
+		opt.Timeout = time.Minute * 5
+	}
+
+	return &Paginator{opt: opt}
+}
+
+// Replies to command with first page and handles button clicks. It blocks until paginator times out
+// (buttons are disabled then) or interaction context is done.
+func (paginator *Paginator) Send(itx *CommandInteraction) error {
+	if paginator.opt.PageCount == 0 {
+		return errors.New("paginator has no pages")
+	}
+
+	page := 0
+	embed, err := paginator.page(page)
+	if err != nil {
+		return err
+	}
+
+	err = itx.SendReply(ResponseMessageData{
+		Embeds:     []Embed{embed},
+		Components: paginator.components(page, false),
+	}, paginator.opt.Ephemeral, nil)
+	if err != nil {
+		return err
+	}
+
+	messageID, err := itx.originalMessageID()
+	if err != nil {
+		return err
+	}
+
+	clicks, cleanup := itx.Client.NewCollector(messageID, 0)
+	defer cleanup()
+
+	timer := time.NewTimer(paginator.opt.Timeout)
+	defer timer.Stop()
+
+	author := itx.invokerID()
+	for {
+		var click *ComponentInteraction
+		select {
+		case <-itx.Context().Done():
+			return itx.Context().Err()
+		case <-timer.C:
+			// Make it clear that buttons don't work anymore.
+			return itx.EditReply(ResponseMessageData{Components: paginator.components(page, true)}, paginator.opt.Ephemeral)
+		case click = <-clicks:
+		}
+
+		if click == nil {
+			return nil // Collector was closed.
+		}
+
+		if !paginator.opt.AllowOthers && click.invokerID() != author {
+			continue
+		}
+
+		timer.Reset(paginator.opt.Timeout)
+		switch click.Data.CustomID {
+		case PAGINATOR_FIRST_CUSTOM_ID:
+			page = 0
+		case PAGINATOR_PREV_CUSTOM_ID:
+			page = max(page-1, 0)
+		case PAGINATOR_NEXT_CUSTOM_ID:
+			page = min(page+1, paginator.opt.PageCount-1)
+		case PAGINATOR_LAST_CUSTOM_ID:
+			page = paginator.opt.PageCount - 1
+		default:
+			continue
+		}
+
+		embed, err := paginator.page(page)
+		if err != nil {
+			return err
+		}
+
+		err = itx.EditReply(ResponseMessageData{
+			Embeds:     []Embed{embed},
+			Components: paginator.components(page, false),
+		}, paginator.opt.Ephemeral)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (paginator *Paginator) page(page int) (Embed, error) {
+	if len(paginator.opt.Pages) > 0 {
+		return paginator.opt.Pages[page], nil
+	}
+
+	if paginator.opt.PageProvider == nil {
+		return Embed{}, errors.New("paginator has neither pages nor page provider")
+	}
+
+	return paginator.opt.PageProvider(page)
+}
+
+func (paginator *Paginator) components(page int, disabled bool) []LayoutComponent {
+	last := paginator.opt.PageCount - 1
+	button := func(customID string, label string, off bool) ButtonComponent {
+		return ButtonComponent{
+			Type:     BUTTON_COMPONENT_TYPE,
+			Style:    SECONDARY_BUTTON_STYLE,
+			CustomID: customID,
+			Label:    label,
+			Disabled: disabled || off,
+		}
+	}
+
+	return []LayoutComponent{
+		ActionRowComponent{
+			Type: ACTION_ROW_COMPONENT_TYPE,
+			Components: []InteractiveComponent{
+				button(PAGINATOR_FIRST_CUSTOM_ID, "«", page == 0),
+				button(PAGINATOR_PREV_CUSTOM_ID, "‹", page == 0),
+				button(PAGINATOR_PAGE_CUSTOM_ID, strconv.Itoa(page+1)+"/"+strconv.Itoa(last+1), true),
+				button(PAGINATOR_NEXT_CUSTOM_ID, "›", page == last),
+				button(PAGINATOR_LAST_CUSTOM_ID, "»", page == last),
+			},
+		},
+	}
+}
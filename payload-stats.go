@@ -0,0 +1,67 @@
+package tempest
+
+import "slices"
+
+// Payload size summary of single command/component/modal. Check Client.LargestInteractions.
+type InteractionPayloadStats struct {
+	Type      InteractionType
+	Name      string // Command name or component/modal custom ID.
+	Count     uint64 // Number of received interactions.
+	TotalSize uint64 // Sum of all payload sizes, in bytes.
+	MaxSize   int    // Largest received payload, in bytes.
+}
+
+func (stats InteractionPayloadStats) AverageSize() int {
+	if stats.Count == 0 {
+		return 0
+	}
+	return int(stats.TotalSize / stats.Count)
+}
+
+type payloadStatsKey struct {
+	Type InteractionType
+	Name string
+}
+
+// Returns up to n commands/components with the largest received payloads (sorted by max size).
+// Use it to find handlers receiving bloated resolved data. Requires ClientOptions.TrackPayloadSizes.
+func (client *Client) LargestInteractions(n int) []InteractionPayloadStats {
+	if client.payloadStats == nil {
+		return nil
+	}
+
+	stats := client.payloadStats.ExportValues()
+	slices.SortFunc(stats, func(a, b InteractionPayloadStats) int {
+		return b.MaxSize - a.MaxSize
+	})
+
+	if n > 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+
+	return stats
+}
+
+// Clears all collected payload size stats.
+func (client *Client) ResetPayloadStats() {
+	if client.payloadStats != nil {
+		client.payloadStats.Reset()
+	}
+}
+
+func (client *Client) recordPayloadSize(interactionType InteractionType, name string, size int) {
+	key := payloadStatsKey{Type: interactionType, Name: name}
+
+	client.payloadStats.mu.Lock()
+	defer client.payloadStats.mu.Unlock()
+
+	stats, ok := client.payloadStats.cache[key]
+	if !ok {
+		stats = InteractionPayloadStats{Type: interactionType, Name: name}
+	}
+
+	stats.Count++
+	stats.TotalSize += uint64(size)
+	stats.MaxSize = max(stats.MaxSize, size)
+	client.payloadStats.cache[key] = stats
+}
@@ -1,5 +1,7 @@
 package tempest
 
+import "slices"
+
 type PermissionFlags BitSet
 
 const (
@@ -54,6 +56,7 @@ const (
 	_
 	SEND_POLLS_PERMISSION_FLAG
 	USE_EXTERNAL_APPS_PERMISSION_FLAG
+	PIN_MESSAGES_PERMISSION_FLAG
 
 	ALL_TEXT_PERMISSION_FLAGS = VIEW_CHANNEL_PERMISSION_FLAG |
 		SEND_MESSAGES_PERMISSION_FLAG |
@@ -65,7 +68,8 @@ const (
 		MENTION_EVERYONE_PERMISSION_FLAG |
 		SEND_VOICE_MESSAGES_PERMISSION_FLAG |
 		SEND_POLLS_PERMISSION_FLAG |
-		USE_EXTERNAL_APPS_PERMISSION_FLAG
+		USE_EXTERNAL_APPS_PERMISSION_FLAG |
+		PIN_MESSAGES_PERMISSION_FLAG
 
 	ALL_THREAD_PERMISSION_FLAGS = MANAGE_THREADS_PERMISSION_FLAG |
 		CREATE_PUBLIC_THREADS_PERMISSION_FLAG |
@@ -114,3 +118,90 @@ const (
 		MANAGE_NICKNAMES_PERMISSION_FLAG |
 		MODERATE_MEMBERS_PERMISSION_FLAG
 )
+
+// Add allows you to add multiple permissions together, producing a new permission set.
+func (p PermissionFlags) Add(permissions ...PermissionFlags) PermissionFlags {
+	for _, permission := range permissions {
+		p |= permission
+	}
+	return p
+}
+
+// Remove allows you to subtract multiple permissions from the set, producing a new permission set.
+func (p PermissionFlags) Remove(permissions ...PermissionFlags) PermissionFlags {
+	for _, permission := range permissions {
+		p &^= permission
+	}
+	return p
+}
+
+// Has will ensure that the set includes all the permissions entered. Administrator permission is not treated specially here,
+// use sets computed with ComputeBasePermissions or ComputeChannelPermissions as they already expand it.
+func (p PermissionFlags) Has(permissions ...PermissionFlags) bool {
+	for _, permission := range permissions {
+		if (p & permission) != permission {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing will check whether the set is missing any one of the permissions.
+func (p PermissionFlags) Missing(permissions ...PermissionFlags) bool {
+	return !p.Has(permissions...)
+}
+
+// Computes member's guild-wide permissions from @everyone & member roles. Guild owner and administrators get all permissions.
+// Guild has to include roles (it does when fetched with Client.FetchGuild).
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func ComputeBasePermissions(guild Guild, member Member) PermissionFlags {
+	if member.User != nil && member.User.ID == guild.OwnerID {
+		return ALL_PERMISSION_FLAGS
+	}
+
+	var permissions PermissionFlags
+	for _, role := range guild.Roles {
+		if role.ID == guild.ID || slices.Contains(member.RoleIDs, role.ID) { // @everyone role has the same ID as guild
+			permissions |= role.PermissionFlags
+		}
+	}
+
+	if permissions.Has(ADMINISTRATOR_PERMISSION_FLAG) {
+		return ALL_PERMISSION_FLAGS
+	}
+
+	return permissions
+}
+
+// Computes member's permissions in channel - base permissions with applied channel overwrites (@everyone, then roles, then member).
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func ComputeChannelPermissions(guild Guild, member Member, channel Channel) PermissionFlags {
+	permissions := ComputeBasePermissions(guild, member)
+	if permissions.Has(ADMINISTRATOR_PERMISSION_FLAG) {
+		return ALL_PERMISSION_FLAGS
+	}
+
+	var roleAllow, roleDeny PermissionFlags
+	var memberOverwrite *PermissionOverwrite
+
+	for i, overwrite := range channel.PermissionOverwrites {
+		switch {
+		case overwrite.Type == ROLE_PERMISSION_OVERWRITE_TYPE && overwrite.ID == guild.ID:
+			permissions = (permissions &^ overwrite.Deny) | overwrite.Allow
+		case overwrite.Type == ROLE_PERMISSION_OVERWRITE_TYPE && slices.Contains(member.RoleIDs, overwrite.ID):
+			roleAllow |= overwrite.Allow
+			roleDeny |= overwrite.Deny
+		case overwrite.Type == MEMBER_PERMISSION_OVERWRITE_TYPE && member.User != nil && overwrite.ID == member.User.ID:
+			memberOverwrite = &channel.PermissionOverwrites[i]
+		}
+	}
+
+	permissions = (permissions &^ roleDeny) | roleAllow
+	if memberOverwrite != nil {
+		permissions = (permissions &^ memberOverwrite.Deny) | memberOverwrite.Allow
+	}
+
+	return permissions
+}
@@ -0,0 +1,66 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// https://discord.com/developers/docs/resources/message#get-channel-pins-message-pin-object
+type PinnedMessage struct {
+	PinnedAt ISOTimestamp `json:"pinned_at"`
+	Message  Message      `json:"message"`
+}
+
+// https://discord.com/developers/docs/resources/message#get-channel-pins-response-structure
+type PinnedMessages struct {
+	Items   []PinnedMessage `json:"items"`
+	HasMore bool            `json:"has_more"` // Pass PinnedAt of last item as before to fetch next page.
+}
+
+// Pins message in channel (up to 250 pins per channel). Requires PIN_MESSAGES permission. Reason is optional and shows up in audit log.
+//
+// https://discord.com/developers/docs/resources/message#pin-message
+func (client *Client) PinMessage(channelID Snowflake, messageID Snowflake, reason string) error {
+	ctx := WithAuditLogReason(context.Background(), reason)
+	_, err := client.Rest.RequestWithContext(ctx, http.MethodPut, "/channels/"+channelID.String()+"/messages/pins/"+messageID.String(), nil)
+	return err
+}
+
+// Requires PIN_MESSAGES permission. Reason is optional and shows up in audit log.
+//
+// https://discord.com/developers/docs/resources/message#unpin-message
+func (client *Client) UnpinMessage(channelID Snowflake, messageID Snowflake, reason string) error {
+	ctx := WithAuditLogReason(context.Background(), reason)
+	_, err := client.Rest.RequestWithContext(ctx, http.MethodDelete, "/channels/"+channelID.String()+"/messages/pins/"+messageID.String(), nil)
+	return err
+}
+
+// Returns pinned messages (up to limit, 1-50), from most recently pinned. Use zero before to start from the latest pin.
+//
+// https://discord.com/developers/docs/resources/message#get-channel-pins
+func (client *Client) FetchPinnedMessages(channelID Snowflake, before time.Time, limit uint8) (PinnedMessages, error) {
+	if limit == 0 || limit > 50 {
+		return PinnedMessages{}, errors.New("limit has to be between 1 and 50")
+	}
+
+	route := "/channels/" + channelID.String() + "/messages/pins?limit=" + strconv.FormatUint(uint64(limit), 10)
+	if !before.IsZero() {
+		route += "&before=" + before.UTC().Format(time.RFC3339Nano)
+	}
+
+	raw, err := client.Rest.Request(http.MethodGet, route, nil)
+	if err != nil {
+		return PinnedMessages{}, err
+	}
+
+	res := PinnedMessages{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return PinnedMessages{}, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package tempest
+
+import "slices"
+
+// Returns CommandMiddleware that stops command execution when invoking user or guild has no active entitlement to given SKU,
+// replying instead with ephemeral premium button (Discord renders it with SKU name & price and opens purchase flow).
+// Gates only listed commands (use "name@subcommand" for subcommands), or all commands if none are listed.
+//
+// Entitlements are checked against ones Discord attaches to each interaction, so newly bought SKU works right away
+// without fetching anything. Use it as one of ClientOptions.CommandMiddlewares.
+func RequireEntitlement(skuID Snowflake, commands ...string) CommandMiddleware {
+	return func(cmd Command, itx *CommandInteraction) bool {
+		if len(commands) != 0 && !slices.Contains(commands, itx.Data.Name) {
+			return true
+		}
+
+		if itx.HasEntitlement(skuID) {
+			return true
+		}
+
+		itx.SendReply(ResponseMessageData{
+			Components: []LayoutComponent{
+				ActionRowComponent{
+					Type: ACTION_ROW_COMPONENT_TYPE,
+					Components: []InteractiveComponent{
+						ButtonComponent{
+							Type:  BUTTON_COMPONENT_TYPE,
+							Style: PREMIUM_BUTTON_STYLE,
+							SkuID: skuID,
+						},
+					},
+				},
+			},
+		}, true, nil)
+		return false
+	}
+}
@@ -0,0 +1,160 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	MAX_BULK_DELETE_MESSAGES = 100
+	MAX_BULK_DELETE_AGE      = time.Hour * 24 * 14 // Bulk delete rejects messages older than 2 weeks.
+)
+
+// Decides whether message should be affected, see Client.PurgeMessages.
+type MessagePredicate func(msg Message) bool
+
+// Reports purge progress after each processed page of channel history.
+type PurgeProgress struct {
+	Scanned int // Number of messages checked against predicate so far.
+	Deleted int // Number of messages deleted so far.
+}
+
+// Matches messages sent by any of given users.
+func FromAuthor(userIDs ...Snowflake) MessagePredicate {
+	return func(msg Message) bool {
+		if msg.Author == nil {
+			return false
+		}
+
+		for _, id := range userIDs {
+			if msg.Author.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Matches messages whose content contains given text (case insensitive).
+func ContainsText(text string) MessagePredicate {
+	text = strings.ToLower(text)
+	return func(msg Message) bool {
+		return strings.Contains(strings.ToLower(msg.Content), text)
+	}
+}
+
+// Matches messages with at least one attachment.
+func HasAttachments() MessagePredicate {
+	return func(msg Message) bool {
+		return len(msg.Attachments) > 0
+	}
+}
+
+// Matches messages sent more than given duration ago.
+func OlderThan(age time.Duration) MessagePredicate {
+	return func(msg Message) bool {
+		return time.Since(msg.ID.CreationTimestamp()) > age
+	}
+}
+
+// Matches messages that pass all given predicates.
+func AllOf(predicates ...MessagePredicate) MessagePredicate {
+	return func(msg Message) bool {
+		for _, predicate := range predicates {
+			if !predicate(msg) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Deletes 2-100 messages at once. Messages older than 2 weeks cannot be bulk deleted. Requires MANAGE_MESSAGES permission.
+//
+// https://discord.com/developers/docs/resources/message#bulk-delete-messages
+func (client *Client) BulkDeleteMessages(channelID Snowflake, messageIDs []Snowflake) error {
+	if len(messageIDs) < 2 || len(messageIDs) > MAX_BULK_DELETE_MESSAGES {
+		return errors.New("bulk delete requires between 2 and 100 messages")
+	}
+
+	_, err := client.Rest.Request(http.MethodPost, "/channels/"+channelID.String()+"/messages/bulk-delete", map[string]any{
+		"messages": messageIDs,
+	})
+	return err
+}
+
+// Walks through channel history (from newest messages) and deletes up to limit messages that pass predicate (use nil predicate to match all).
+// Messages younger than 2 weeks are removed with bulk delete, older ones have to be deleted one by one (which is much slower due to rate limits).
+// Optional progress function is called after each page of history. Returns number of deleted messages.
+func (client *Client) PurgeMessages(channelID Snowflake, predicate MessagePredicate, limit int, progress func(progress PurgeProgress)) (int, error) {
+	var state PurgeProgress
+	var before Snowflake
+
+	for state.Deleted < limit {
+		page := make([]Message, 0, MAX_BULK_DELETE_MESSAGES)
+		err := client.StreamMessages(channelID, MAX_BULK_DELETE_MESSAGES, before, func(msg Message) error {
+			page = append(page, msg)
+			return nil
+		})
+		if err != nil {
+			return state.Deleted, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		// Leave a bit of margin so messages don't cross 2 week mark while request is on its way.
+		bulkCutoff := time.Now().Add(-MAX_BULK_DELETE_AGE + time.Minute)
+		var bulk, single []Snowflake
+		for _, msg := range page {
+			if state.Deleted+len(bulk)+len(single) >= limit {
+				break
+			}
+
+			state.Scanned++
+
+			if predicate != nil && !predicate(msg) {
+				continue
+			}
+
+			if msg.ID.CreationTimestamp().After(bulkCutoff) {
+				bulk = append(bulk, msg.ID)
+			} else {
+				single = append(single, msg.ID)
+			}
+		}
+
+		if len(bulk) == 1 {
+			single = append(single, bulk[0])
+			bulk = nil
+		}
+
+		if len(bulk) > 0 {
+			if err := client.BulkDeleteMessages(channelID, bulk); err != nil {
+				return state.Deleted, err
+			}
+			state.Deleted += len(bulk)
+		}
+
+		for _, id := range single {
+			if err := client.DeleteMessage(channelID, id); err != nil {
+				return state.Deleted, err
+			}
+			state.Deleted++
+		}
+
+		if progress != nil {
+			progress(state)
+		}
+
+		if len(page) < MAX_BULK_DELETE_MESSAGES {
+			break
+		}
+		before = page[len(page)-1].ID
+	}
+
+	return state.Deleted, nil
+}
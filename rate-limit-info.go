@@ -0,0 +1,90 @@
+package tempest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Rate limit state of bucket, as reported by Discord with response headers.
+//
+// https://discord.com/developers/docs/topics/rate-limits#header-format
+type RateLimitInfo struct {
+	Bucket     string        // Discord's bucket hash.
+	Limit      int           // Number of requests that can be made within bucket.
+	Remaining  int           // Number of requests left before bucket resets.
+	ResetAfter time.Duration // Time until bucket resets.
+	ResetAt    time.Time
+	Scope      string // Only on 429 responses: "user", "global" or "shared".
+}
+
+// Optional metadata about finished request, see WithResultInfo.
+type ResultInfo struct {
+	StatusCode   int // 0 if request failed before receiving response.
+	Latency      time.Duration
+	RateLimit    RateLimitInfo
+	HasRateLimit bool // Whether response included rate limit headers.
+}
+
+type resultInfoKey struct{}
+
+// Returns context that makes Rest fill info once request (sent with that context) finishes, whether it succeeded or not.
+// If request was retried, info describes the last attempt. High throughput senders can use it to slow down before hitting rate limits:
+//
+//	var info tempest.ResultInfo
+//	_, err := client.SendMessageWithContext(tempest.WithResultInfo(ctx, &info), channelID, msg, nil)
+//	if info.HasRateLimit && info.RateLimit.Remaining == 0 {
+//		time.Sleep(info.RateLimit.ResetAfter)
+//	}
+func WithResultInfo(ctx context.Context, info *ResultInfo) context.Context {
+	return context.WithValue(ctx, resultInfoKey{}, info)
+}
+
+// Reads rate limit headers from response. Returns false if response has none (not every route is rate limited per bucket).
+func ParseRateLimitInfo(header http.Header) (RateLimitInfo, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimitInfo{}, false
+	}
+
+	info := RateLimitInfo{
+		Bucket: header.Get("X-RateLimit-Bucket"),
+		Limit:  limit,
+		Scope:  header.Get("X-RateLimit-Scope"),
+	}
+
+	info.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if resetAfter, err := strconv.ParseFloat(header.Get("X-RateLimit-Reset-After"), 64); err == nil {
+		info.ResetAfter = time.Duration(resetAfter * float64(time.Second))
+	}
+
+	if reset, err := strconv.ParseFloat(header.Get("X-RateLimit-Reset"), 64); err == nil {
+		info.ResetAt = time.UnixMilli(int64(reset * 1000))
+	}
+
+	return info, true
+}
+
+func fillResultInfo(ctx context.Context, res *http.Response, latency time.Duration) *RateLimitInfo {
+	var rateLimit *RateLimitInfo
+	if res != nil {
+		if info, ok := ParseRateLimitInfo(res.Header); ok {
+			rateLimit = &info
+		}
+	}
+
+	if info, ok := ctx.Value(resultInfoKey{}).(*ResultInfo); ok && info != nil {
+		*info = ResultInfo{Latency: latency}
+		if res != nil {
+			info.StatusCode = res.StatusCode
+		}
+
+		if rateLimit != nil {
+			info.RateLimit = *rateLimit
+			info.HasRateLimit = true
+		}
+	}
+
+	return rateLimit
+}
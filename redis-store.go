@@ -0,0 +1,297 @@
+package tempest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// RedisStore is a CacheStore backed by Redis (or any server speaking RESP protocol, like Valkey or KeyDB).
+// Use it for Cache and RequestScheduler.Store when multiple processes (for example replicas behind load balancer)
+// should share cached entities & rate limit state.
+//
+// It implements just enough of RESP protocol to not pull any external dependency.
+// Failed operations are treated as cache misses - use Ping to check connection health.
+type RedisStore struct {
+	opt  RedisStoreOptions
+	pool chan *redisConn
+}
+
+type RedisStoreOptions struct {
+	Address     string        // Address of Redis server, like "localhost:6379".
+	Password    string        // Optional password used with AUTH command.
+	DB          uint16        // Index of logical database to use.
+	Prefix      string        // Optional prefix added to all keys, handy when Redis is shared with other apps.
+	PoolSize    uint8         // Max number of idle connections kept open, defaults to 8.
+	DialTimeout time.Duration // Defaults to 5 seconds.
+	Timeout     time.Duration // Read/write deadline of each command, defaults to 3 seconds.
+}
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// Creates new store and checks connection to Redis server.
+func NewRedisStore(opt RedisStoreOptions) (*RedisStore, error) {
+	if opt.PoolSize == 0 {
+		opt.PoolSize = 8
+	}
+
+	if opt.DialTimeout == 0 {
+		opt.DialTimeout = time.Second * 5
+	}
+
+	if opt.Timeout == 0 {
+		opt.Timeout = time.Second * 3
+	}
+
+	store := &RedisStore{
+		opt:  opt,
+		pool: make(chan *redisConn, opt.PoolSize),
+	}
+
+	if err := store.Ping(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *RedisStore) Get(key string) ([]byte, bool) {
+	res, err := store.Do("GET", store.opt.Prefix+key)
+	if err != nil || res == nil {
+		return nil, false
+	}
+
+	value, ok := res.([]byte)
+	return value, ok
+}
+
+func (store *RedisStore) Set(key string, value []byte, ttl time.Duration) {
+	if ttl > 0 {
+		store.Do("SET", store.opt.Prefix+key, value, "PX", redisTTL(ttl))
+		return
+	}
+
+	store.Do("SET", store.opt.Prefix+key, value)
+}
+
+func (store *RedisStore) SetIfAbsent(key string, value []byte, ttl time.Duration) bool {
+	ok, err := store.TrySetIfAbsent(key, value, ttl)
+	return err == nil && ok
+}
+
+// Same as SetIfAbsent, but returns error if command failed, so it isn't mistaken for already existing key.
+func (store *RedisStore) TrySetIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	var res any
+	var err error
+	if ttl > 0 {
+		res, err = store.Do("SET", store.opt.Prefix+key, value, "NX", "PX", redisTTL(ttl))
+	} else {
+		res, err = store.Do("SET", store.opt.Prefix+key, value, "NX")
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return res != nil, nil
+}
+
+// Formats TTL for PX argument. Redis rejects "PX 0", so sub-millisecond TTLs are rounded up.
+func redisTTL(ttl time.Duration) string {
+	return strconv.FormatInt(max(ttl.Milliseconds(), 1), 10)
+}
+
+func (store *RedisStore) Delete(key string) {
+	store.Do("DEL", store.opt.Prefix+key)
+}
+
+// Checks whether Redis server is reachable.
+func (store *RedisStore) Ping() error {
+	_, err := store.Do("PING")
+	return err
+}
+
+// Closes all idle connections.
+func (store *RedisStore) Close() {
+	for {
+		select {
+		case rc := <-store.pool:
+			rc.conn.Close()
+		default:
+			return
+		}
+	}
+}
+
+// Sends raw command to Redis server. Arguments have to be either string or []byte.
+// Returned value is string (simple strings), int64 (integers), []byte (bulk strings), []any (arrays) or nil.
+func (store *RedisStore) Do(args ...any) (any, error) {
+	rc, err := store.conn()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := rc.do(store.opt.Timeout, args...)
+	if err != nil {
+		var redisErr redisError
+		if !errors.As(err, &redisErr) {
+			rc.conn.Close() // Connection is in unknown state, don't reuse it.
+			return nil, err
+		}
+	}
+
+	select {
+	case store.pool <- rc:
+	default:
+		rc.conn.Close()
+	}
+
+	return res, err
+}
+
+func (store *RedisStore) conn() (*redisConn, error) {
+	select {
+	case rc := <-store.pool:
+		return rc, nil
+	default:
+	}
+
+	conn, err := net.DialTimeout("tcp", store.opt.Address, store.opt.DialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect with redis: %w", err)
+	}
+
+	rc := &redisConn{conn: conn, reader: bufio.NewReader(conn)}
+
+	if store.opt.Password != "" {
+		if _, err := rc.do(store.opt.Timeout, "AUTH", store.opt.Password); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to authenticate with redis: %w", err)
+		}
+	}
+
+	if store.opt.DB != 0 {
+		if _, err := rc.do(store.opt.Timeout, "SELECT", strconv.FormatUint(uint64(store.opt.DB), 10)); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to select redis database: %w", err)
+		}
+	}
+
+	return rc, nil
+}
+
+// Error reply sent by Redis server (connection remains usable after it).
+type redisError string
+
+func (err redisError) Error() string {
+	return "redis: " + string(err)
+}
+
+func (rc *redisConn) do(timeout time.Duration, args ...any) (any, error) {
+	rc.conn.SetDeadline(time.Now().Add(timeout))
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+
+	for _, arg := range args {
+		var value []byte
+		switch v := arg.(type) {
+		case string:
+			value = []byte(v)
+		case []byte:
+			value = v
+		default:
+			return nil, fmt.Errorf("unsupported redis argument type: %T", arg)
+		}
+
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(value)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, value...)
+		buf = append(buf, '\r', '\n')
+	}
+
+	if _, err := rc.conn.Write(buf); err != nil {
+		return nil, err
+	}
+
+	return rc.readReply()
+}
+
+func (rc *redisConn) readReply() (any, error) {
+	line, err := rc.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) < 3 {
+		return nil, errors.New("redis: malformed reply")
+	}
+
+	payload := line[1 : len(line)-2]
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, redisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		value := make([]byte, size+2)
+		if _, err := io.ReadFull(rc.reader, value); err != nil {
+			return nil, err
+		}
+
+		return value[:size], nil
+	case '*':
+		size, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		// Whole array has to be read even if some element is an error, otherwise leftovers would desync connection.
+		res := make([]any, size)
+		var replyErr error
+		for i := range res {
+			if res[i], err = rc.readReply(); err != nil {
+				var redisErr redisError
+				if !errors.As(err, &redisErr) {
+					return nil, err
+				}
+
+				if replyErr == nil {
+					replyErr = err
+				}
+			}
+		}
+
+		if replyErr != nil {
+			return nil, replyErr
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("redis: unknown reply type %q", line[0])
+}
@@ -0,0 +1,96 @@
+package tempest
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Returns IP address of whoever sent request. If request came from one of ClientOptions.TrustedProxies,
+// IP is read from ClientOptions.RealIPHeader instead (for X-Forwarded-For, it's the last address that isn't trusted proxy).
+// Use it to rate limit or log requests reaching interaction endpoint. Returns zero (invalid) address if it cannot be parsed.
+func (client *Client) RealIP(r *http.Request) netip.Addr {
+	remote := remoteAddr(r)
+	if client.realIPHeader == "" || !remote.IsValid() || !containsAddr(client.trustedProxies, remote) {
+		return remote
+	}
+
+	values := r.Header.Values(client.realIPHeader)
+	if len(values) == 0 {
+		return remote
+	}
+
+	// Proxies append to list, so walk it backwards and stop at first hop we don't control.
+	hops := strings.Split(strings.Join(values, ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			return remote
+		}
+
+		addr = addr.Unmap()
+		if i == 0 || !containsAddr(client.trustedProxies, addr) {
+			return addr
+		}
+	}
+
+	return remote
+}
+
+// Checks whether request comes from one of ClientOptions.AllowedSources. Always true if allowlist is empty.
+func (client *Client) IsAllowedSource(r *http.Request) bool {
+	if len(client.allowedSources) == 0 {
+		return true
+	}
+
+	ip := client.RealIP(r)
+	return ip.IsValid() && containsAddr(client.allowedSources, ip)
+}
+
+// Parses list of CIDR ranges or single IP addresses.
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, err
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return prefixes, nil
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteAddr(r *http.Request) netip.Addr {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
@@ -0,0 +1,121 @@
+package tempest
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+type ResourceType uint8
+
+const (
+	CHANNEL_RESOURCE_TYPE ResourceType = iota + 1
+	ROLE_RESOURCE_TYPE
+	WEBHOOK_RESOURCE_TYPE
+	MESSAGE_RESOURCE_TYPE // Usually messages with components that bot keeps listening to.
+)
+
+// Record of Discord entity created by bot, see ResourceRegistry.
+type OwnedResource struct {
+	Type      ResourceType `json:"type"`
+	ID        Snowflake    `json:"id"`
+	ChannelID Snowflake    `json:"channel_id,omitempty"` // Parent channel of messages & webhooks.
+	Label     string       `json:"label,omitempty"`      // Optional note, like name of feature that created resource.
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// ResourceRegistry keeps track of resources bot created in each guild, so they can be listed in audits or removed
+// by cleanup commands. Set it as ClientOptions.ResourceRegistry to record channels created with Client.CreateChannel automatically.
+// Tempest has no helpers for creating roles & webhooks, and messages returned by Discord API don't carry guild ID,
+// so record those with ResourceRegistry.Track once created.
+//
+// Updates are serialized within single process only - when store is shared between replicas, concurrent updates
+// of the same guild may overwrite each other.
+type ResourceRegistry struct {
+	store CacheStore
+	mu    sync.Mutex
+}
+
+// Creates registry that keeps records in given store (use nil for in-memory store).
+func NewResourceRegistry(store CacheStore) *ResourceRegistry {
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+
+	return &ResourceRegistry{store: store}
+}
+
+// Records resource created by bot in guild. Zero CreatedAt is replaced with current time.
+func (registry *ResourceRegistry) Track(guildID Snowflake, resource OwnedResource) {
+	if resource.CreatedAt.IsZero() {
+		resource.CreatedAt = time.Now()
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	resources := registry.load(guildID)
+	resources = slices.DeleteFunc(resources, func(r OwnedResource) bool {
+		return r.Type == resource.Type && r.ID == resource.ID
+	})
+	registry.save(guildID, append(resources, resource))
+}
+
+// Removes record of resource (for example after it was deleted).
+func (registry *ResourceRegistry) Forget(guildID Snowflake, resourceType ResourceType, resourceID Snowflake) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	resources := registry.load(guildID)
+	registry.save(guildID, slices.DeleteFunc(resources, func(r OwnedResource) bool {
+		return r.Type == resourceType && r.ID == resourceID
+	}))
+}
+
+// Returns all recorded resources in guild, from oldest to newest.
+func (registry *ResourceRegistry) Resources(guildID Snowflake) []OwnedResource {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	return registry.load(guildID)
+}
+
+// Returns recorded resources of given type in guild.
+func (registry *ResourceRegistry) ResourcesOfType(guildID Snowflake, resourceType ResourceType) []OwnedResource {
+	return slices.DeleteFunc(registry.Resources(guildID), func(r OwnedResource) bool {
+		return r.Type != resourceType
+	})
+}
+
+// Removes all records for guild (for example once bot was removed from it).
+func (registry *ResourceRegistry) Clear(guildID Snowflake) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.store.Delete(OWNED_RESOURCES_KEY_PREFIX + guildID.String())
+}
+
+func (registry *ResourceRegistry) load(guildID Snowflake) []OwnedResource {
+	raw, ok := registry.store.Get(OWNED_RESOURCES_KEY_PREFIX + guildID.String())
+	if !ok {
+		return nil
+	}
+
+	var resources []OwnedResource
+	if err := unmarshalJSON(raw, &resources); err != nil {
+		return nil
+	}
+	return resources
+}
+
+func (registry *ResourceRegistry) save(guildID Snowflake, resources []OwnedResource) {
+	key := OWNED_RESOURCES_KEY_PREFIX + guildID.String()
+	if len(resources) == 0 {
+		registry.store.Delete(key)
+		return
+	}
+
+	raw, err := marshalJSON(resources)
+	if err != nil {
+		return
+	}
+	registry.store.Set(key, raw, 0)
+}
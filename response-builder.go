@@ -0,0 +1,184 @@
+package tempest
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ResponseBuilder helps compose message responses step by step. Result can be used for initial replies,
+// follow-ups and edits alike, for example:
+//
+//	data, files := tempest.NewResponse().Content("Done!").Ephemeral().Build()
+//	itx.SendReply(data, false, files)
+type ResponseBuilder struct {
+	data          ResponseMessageData
+	files         []File
+	fileThreshold int
+	maxMessages   int
+}
+
+func NewResponse() *ResponseBuilder {
+	return &ResponseBuilder{}
+}
+
+func (builder *ResponseBuilder) Content(content string) *ResponseBuilder {
+	builder.data.Content = content
+	return builder
+}
+
+// Appends embeds to response (max 10).
+func (builder *ResponseBuilder) Embeds(embeds ...Embed) *ResponseBuilder {
+	builder.data.Embeds = append(builder.data.Embeds, embeds...)
+	return builder
+}
+
+// Appends layout components (like action rows) to response.
+func (builder *ResponseBuilder) Components(components ...LayoutComponent) *ResponseBuilder {
+	builder.data.Components = append(builder.data.Components, components...)
+	return builder
+}
+
+// Attaches files to response. Use "attachment://<file name>" to reference them in embeds.
+func (builder *ResponseBuilder) Files(files ...File) *ResponseBuilder {
+	builder.files = append(builder.files, files...)
+	return builder
+}
+
+func (builder *ResponseBuilder) AllowedMentions(mentions AllowedMentions) *ResponseBuilder {
+	builder.data.AllowedMentions = &mentions
+	return builder
+}
+
+func (builder *ResponseBuilder) TTS() *ResponseBuilder {
+	builder.data.TTS = true
+	return builder
+}
+
+// Makes message visible only to user who triggered interaction. It's ignored for regular channel messages.
+func (builder *ResponseBuilder) Ephemeral() *ResponseBuilder {
+	return builder.Flags(EPHEMERAL_MESSAGE_FLAG)
+}
+
+// Hides link previews (embeds) generated from URLs in content.
+func (builder *ResponseBuilder) SuppressEmbeds() *ResponseBuilder {
+	return builder.Flags(SUPPRESS_EMBEDS_MESSAGE_FLAG)
+}
+
+// Sends message without push & desktop notifications ("@silent" message).
+func (builder *ResponseBuilder) SuppressNotifications() *ResponseBuilder {
+	return builder.Flags(SUPPRESS_NOTIFICATIONS_MESSAGE_FLAG)
+}
+
+// Adds any other message flags.
+func (builder *ResponseBuilder) Flags(flags ...MessageFlags) *ResponseBuilder {
+	for _, flag := range flags {
+		builder.data.Flags |= flag
+	}
+	return builder
+}
+
+// Makes content longer than threshold characters be sent as "message.txt" attachment instead of being split
+// into multiple messages. It only affects BuildSplit, SendSplit & Reply methods.
+func (builder *ResponseBuilder) LongContentAsFile(threshold int) *ResponseBuilder {
+	builder.fileThreshold = threshold
+	return builder
+}
+
+// Makes content that would need more than maxMessages messages be sent as "message.txt" attachment instead.
+// It only affects BuildSplit, SendSplit & Reply methods.
+func (builder *ResponseBuilder) MaxSplitMessages(maxMessages int) *ResponseBuilder {
+	builder.maxMessages = maxMessages
+	return builder
+}
+
+// Returns response split into as many messages as needed for content to fit in MAX_MESSAGE_CONTENT_LENGTH (check SplitContent).
+// Embeds & components are attached to the last message, files to the first one.
+func (builder *ResponseBuilder) BuildSplit() ([]ResponseMessageData, []File) {
+	files := builder.files
+	content := builder.data.Content
+	chunks := SplitContent(content, MAX_MESSAGE_CONTENT_LENGTH)
+
+	if (builder.fileThreshold > 0 && utf8.RuneCountInString(content) > builder.fileThreshold) || (builder.maxMessages > 0 && len(chunks) > builder.maxMessages) {
+		files = append(slices.Clone(files), File{Name: "message.txt", Reader: strings.NewReader(content)})
+		chunks = []string{""}
+	}
+
+	parts := make([]ResponseMessageData, len(chunks))
+	for i, chunk := range chunks {
+		parts[i] = ResponseMessageData{
+			Content:         chunk,
+			TTS:             builder.data.TTS,
+			AllowedMentions: builder.data.AllowedMentions,
+			Flags:           builder.data.Flags,
+		}
+	}
+
+	last := &parts[len(parts)-1]
+	last.Embeds = builder.data.Embeds
+	last.Components = builder.data.Components
+	return parts, files
+}
+
+// Sends response to channel, split into as many messages as needed (check BuildSplit). Returns all sent messages.
+func (builder *ResponseBuilder) SendSplit(client *Client, channelID Snowflake) ([]Message, error) {
+	parts, files := builder.BuildSplit()
+	messages := make([]Message, 0, len(parts))
+
+	for i, part := range parts {
+		var partFiles []File
+		if i == 0 {
+			partFiles = files
+		}
+
+		msg, err := client.SendMessage(channelID, Message{
+			Content:         part.Content,
+			TTS:             part.TTS,
+			Embeds:          part.Embeds,
+			Components:      part.Components,
+			Flags:           part.Flags,
+			AllowedMentions: part.AllowedMentions,
+		}, partFiles)
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// Replies to command with first part of response and sends remaining parts as follow-ups (check BuildSplit).
+func (builder *ResponseBuilder) Reply(itx *CommandInteraction) error {
+	parts, files := builder.BuildSplit()
+	ephemeral := builder.data.Flags.Has(EPHEMERAL_MESSAGE_FLAG)
+
+	if err := itx.SendReply(parts[0], ephemeral, files); err != nil {
+		return err
+	}
+
+	for _, part := range parts[1:] {
+		if _, err := itx.SendFollowUp(part, ephemeral); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns response data & attached files, ready to use with interaction reply, follow-up & edit methods.
+func (builder *ResponseBuilder) Build() (ResponseMessageData, []File) {
+	return builder.data, builder.files
+}
+
+// Returns response as regular message with attached files, ready to use with Client.SendMessage & Client.EditMessage.
+func (builder *ResponseBuilder) BuildMessage() (Message, []File) {
+	return Message{
+		Content:         builder.data.Content,
+		TTS:             builder.data.TTS,
+		Embeds:          builder.data.Embeds,
+		Components:      builder.data.Components,
+		Flags:           builder.data.Flags,
+		AllowedMentions: builder.data.AllowedMentions,
+	}, builder.files
+}
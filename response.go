@@ -12,8 +12,8 @@ const (
 	DEFERRED_UPDATE_MESSAGE_RESPONSE_TYPE // Only valid for component-based interactions.
 	UPDATE_MESSAGE_RESPONSE_TYPE          // Only valid for component-based interactions.
 	AUTOCOMPLETE_RESPONSE_TYPE
-	MODAL_RESPONSE_TYPE // Not available for MODAL_SUBMIT and PING interactions.
-	_
+	MODAL_RESPONSE_TYPE            // Not available for MODAL_SUBMIT and PING interactions.
+	PREMIUM_REQUIRED_RESPONSE_TYPE // Deprecated: respond with PREMIUM_BUTTON_STYLE button instead. Shows upgrade prompt for premium SKU, only for monetized apps.
 	_
 	LAUNCH_ACTIVITY_RESPONSE_TYPE // Launch the Activity associated with the app. Only available for apps with Activities enabled.
 )
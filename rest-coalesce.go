@@ -0,0 +1,52 @@
+package tempest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Deduplicates identical GET requests that are in flight at the same time, see Rest.CoalesceRequests.
+type requestGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightRequest
+}
+
+type inflightRequest struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// Runs fn once per route at a time - callers that come while it's in flight wait for its result instead.
+// Request runs with context detached from cancellation of its first caller, each caller still stops waiting once its own context is done.
+func (group *requestGroup) do(ctx context.Context, route string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	group.mu.Lock()
+	if group.calls == nil {
+		group.calls = make(map[string]*inflightRequest)
+	}
+
+	call, ok := group.calls[route]
+	if !ok {
+		call = &inflightRequest{done: make(chan struct{})}
+		group.calls[route] = call
+
+		go func() {
+			call.body, call.err = fn(context.WithoutCancel(ctx))
+
+			group.mu.Lock()
+			delete(group.calls, route)
+			group.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	group.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return bytes.Clone(call.body), call.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("request to GET %s cancelled: %w", route, ctx.Err())
+	}
+}
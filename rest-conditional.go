@@ -0,0 +1,72 @@
+package tempest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const (
+	CONDITIONAL_CACHE_KEY_PREFIX = "conditional:"
+	DEFAULT_CONDITIONAL_TTL      = time.Hour
+)
+
+// Cached GET response, stored in Rest.ConditionalStore.
+type conditionalEntry struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Body         []byte `json:"body"`
+}
+
+// Returns cached response of given route (if conditional caching is enabled and route has any) and adds validators to request.
+func (rest *Rest) applyConditional(req *http.Request, route string) *conditionalEntry {
+	if rest.ConditionalStore == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	raw, ok := rest.ConditionalStore.Get(CONDITIONAL_CACHE_KEY_PREFIX + route)
+	if !ok {
+		return nil
+	}
+
+	var entry conditionalEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		rest.ConditionalStore.Delete(CONDITIONAL_CACHE_KEY_PREFIX + route)
+		return nil
+	}
+
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	return &entry
+}
+
+// Stores successful GET response if Discord attached any validator (ETag or Last-Modified) to it.
+func (rest *Rest) storeConditional(route string, header http.Header, body []byte) {
+	entry := conditionalEntry{
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		Body:         body,
+	}
+
+	if entry.ETag == "" && entry.LastModified == "" {
+		return
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	ttl := rest.ConditionalTTL
+	if ttl == 0 {
+		ttl = DEFAULT_CONDITIONAL_TTL
+	}
+
+	rest.ConditionalStore.Set(CONDITIONAL_CACHE_KEY_PREFIX+route, raw, ttl)
+}
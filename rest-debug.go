@@ -0,0 +1,257 @@
+package tempest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redactedValue = "[redacted]"
+
+// Single request & response pair captured by DebugRecorder. Secrets (auth header, interaction tokens & token fields in bodies) are redacted.
+type DebugEntry struct {
+	Time            time.Time     `json:"time"`
+	Method          string        `json:"method"`
+	Route           string        `json:"route"`
+	RequestHeaders  http.Header   `json:"request_headers,omitempty"`
+	RequestBody     string        `json:"request_body,omitempty"`
+	Status          int           `json:"status,omitempty"` // 0 if request failed before receiving response.
+	ResponseHeaders http.Header   `json:"response_headers,omitempty"`
+	ResponseBody    string        `json:"response_body,omitempty"`
+	Latency         time.Duration `json:"latency"`
+	Error           string        `json:"error,omitempty"`
+}
+
+type DebugRecorderOptions struct {
+	Size        int    // Number of most recent entries kept in memory, defaults to 100.
+	Directory   string // Optional directory where entries are also appended (as JSON lines) to "tempest-debug.log".
+	MaxFileSize int64  // Size after which log file is rotated, defaults to 10 MB.
+	MaxFiles    int    // Number of rotated files to keep (like "tempest-debug.log.1"), defaults to 5.
+	MaxBodySize int    // Bodies longer than that are cut, defaults to 64 KB.
+}
+
+// DebugRecorder captures REST request/response pairs in a ring buffer (and optionally rotating files).
+// Attach it with Rest.Debug when chasing intermittent API errors - it's too costly to keep it on all the time.
+type DebugRecorder struct {
+	opt     DebugRecorderOptions
+	mu      sync.Mutex
+	entries []DebugEntry
+	next    int
+	full    bool
+	file    *os.File
+	written int64
+}
+
+func NewDebugRecorder(opt DebugRecorderOptions) (*DebugRecorder, error) {
+	if opt.Size <= 0 {
+		opt.Size = 100
+	}
+
+	if opt.MaxFileSize <= 0 {
+		opt.MaxFileSize = 10 << 20
+	}
+
+	if opt.MaxFiles <= 0 {
+		opt.MaxFiles = 5
+	}
+
+	if opt.MaxBodySize <= 0 {
+		opt.MaxBodySize = 64 << 10
+	}
+
+	recorder := &DebugRecorder{opt: opt, entries: make([]DebugEntry, opt.Size)}
+	if opt.Directory != "" {
+		if err := os.MkdirAll(opt.Directory, 0o755); err != nil {
+			return nil, err
+		}
+
+		if err := recorder.openFile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return recorder, nil
+}
+
+// Returns copy of recorded entries, from oldest to newest.
+func (recorder *DebugRecorder) Entries() []DebugEntry {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	if !recorder.full {
+		return append([]DebugEntry(nil), recorder.entries[:recorder.next]...)
+	}
+
+	res := make([]DebugEntry, 0, len(recorder.entries))
+	res = append(res, recorder.entries[recorder.next:]...)
+	return append(res, recorder.entries[:recorder.next]...)
+}
+
+// Closes log file (if any). Recorder keeps collecting entries in memory afterwards.
+func (recorder *DebugRecorder) Close() error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	if recorder.file == nil {
+		return nil
+	}
+
+	err := recorder.file.Close()
+	recorder.file = nil
+	return err
+}
+
+// Records entry with (optional) error that ended request.
+func (recorder *DebugRecorder) record(entry DebugEntry, err error) {
+	if err != nil {
+		entry.Error = stripRequestURL(err).Error()
+	}
+
+	entry.Route = redactRoute(entry.Route)
+	entry.RequestHeaders = redactHeaders(entry.RequestHeaders)
+	entry.RequestBody = recorder.redactBody(entry.RequestBody)
+	entry.ResponseBody = recorder.redactBody(entry.ResponseBody)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.entries[recorder.next] = entry
+	recorder.next = (recorder.next + 1) % len(recorder.entries)
+	if recorder.next == 0 {
+		recorder.full = true
+	}
+
+	if recorder.file == nil {
+		return
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	line = append(line, '\n')
+	if recorder.written > 0 && recorder.written+int64(len(line)) > recorder.opt.MaxFileSize {
+		if recorder.rotate() != nil {
+			return
+		}
+	}
+
+	n, _ := recorder.file.Write(line)
+	recorder.written += int64(n)
+}
+
+func (recorder *DebugRecorder) path() string {
+	return filepath.Join(recorder.opt.Directory, "tempest-debug.log")
+}
+
+func (recorder *DebugRecorder) openFile() error {
+	file, err := os.OpenFile(recorder.path(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	recorder.file = file
+	recorder.written = info.Size()
+	return nil
+}
+
+func (recorder *DebugRecorder) rotate() error {
+	recorder.file.Close()
+	recorder.file = nil
+
+	path := recorder.path()
+	os.Remove(path + "." + strconv.Itoa(recorder.opt.MaxFiles))
+	for i := recorder.opt.MaxFiles - 1; i >= 1; i-- {
+		os.Rename(path+"."+strconv.Itoa(i), path+"."+strconv.Itoa(i+1))
+	}
+
+	if err := os.Rename(path, path+".1"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return recorder.openFile()
+}
+
+func (recorder *DebugRecorder) redactBody(body string) string {
+	if body == "" {
+		return ""
+	}
+
+	var value any
+	if json.Unmarshal([]byte(body), &value) == nil {
+		if redacted, err := json.Marshal(redactJSON(value)); err == nil {
+			body = string(redacted)
+		}
+	}
+
+	if len(body) > recorder.opt.MaxBodySize {
+		body = body[:recorder.opt.MaxBodySize] + "... (truncated)"
+	}
+
+	return body
+}
+
+// Replaces interaction & webhook tokens in route.
+func redactRoute(route string) string {
+	parts := strings.Split(route, "/")
+	for i := 2; i < len(parts); i++ {
+		if (parts[i-2] == "webhooks" || parts[i-2] == "interactions") && isNumericID(parts[i-1]) {
+			parts[i] = redactedValue
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Returns cause of transport error without request URL, which may carry interaction or webhook token.
+func stripRequestURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+
+	headers = headers.Clone()
+	if headers.Get("Authorization") != "" {
+		headers.Set("Authorization", redactedValue)
+	}
+	return headers
+}
+
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			switch key {
+			case "token", "access_token", "refresh_token", "client_secret":
+				v[key] = redactedValue
+			default:
+				v[key] = redactJSON(inner)
+			}
+		}
+	case []any:
+		for i, inner := range v {
+			v[i] = redactJSON(inner)
+		}
+	}
+	return value
+}
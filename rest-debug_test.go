@@ -0,0 +1,43 @@
+package tempest_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+const webhookToken = "secret-webhook-token"
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestDebugRecorderRedactsTransportError(t *testing.T) {
+	recorder, err := tempest.NewDebugRecorder(tempest.DebugRecorderOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rest := tempest.NewRestWithAuth(tempest.NO_AUTH_MODE, "")
+	rest.HTTPClient.Transport = failingTransport{}
+	rest.MaxRetries = 1
+	rest.Debug = recorder
+
+	if _, err := rest.Request(http.MethodPost, "/webhooks/1144027356181467136/"+webhookToken, nil); err == nil {
+		t.Fatal("expected request to fail")
+	}
+
+	entries := recorder.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+
+	if entries[0].Error == "" || strings.Contains(entries[0].Error, webhookToken) {
+		t.Fatalf("expected redacted error, got %q", entries[0].Error)
+	}
+}
@@ -0,0 +1,106 @@
+package tempest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DEFAULT_DEGRADED_COOLDOWN = time.Second * 5
+	MAX_DEGRADED_COOLDOWN     = time.Minute * 5
+)
+
+type degradedState struct {
+	mu       sync.Mutex
+	since    time.Time // Zero while API is healthy.
+	until    time.Time // Requests fail fast until then.
+	failures uint
+	probing  bool
+}
+
+// Whether response status means Discord API (or its edge) is down, like during maintenance.
+func isUnavailableStatus(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
+// Checks whether request can be sent. While API is degraded, only single (probe) request is let through
+// once cooldown passes and all others fail fast with ErrAPIDegraded.
+func (rest *Rest) admitRequest() (bool, error) {
+	state := &rest.degraded
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.since.IsZero() {
+		return false, nil
+	}
+
+	if state.probing || time.Now().Before(state.until) {
+		return false, fmt.Errorf("%w (since %s)", ErrAPIDegraded, state.since.Format(time.RFC3339))
+	}
+
+	state.probing = true
+	return true, nil
+}
+
+// Lets another request probe API, after probing one ended without response.
+func (rest *Rest) cancelProbe() {
+	rest.degraded.mu.Lock()
+	rest.degraded.probing = false
+	rest.degraded.mu.Unlock()
+}
+
+// Updates API health based on finished request. It's only called for requests that received response.
+func (rest *Rest) reportHealth(probe bool, err error) {
+	var restErr *RestError
+	unavailable := errors.As(err, &restErr) && isUnavailableStatus(restErr.StatusCode)
+
+	state := &rest.degraded
+	state.mu.Lock()
+	if probe {
+		state.probing = false
+	}
+
+	if unavailable {
+		now := time.Now()
+		first := state.since.IsZero()
+		if first {
+			state.since = now
+		}
+
+		base := rest.DegradedCooldown
+		if base <= 0 {
+			base = DEFAULT_DEGRADED_COOLDOWN
+		}
+
+		// Back off exponentially with +-20% jitter so replicas don't probe in lockstep.
+		cooldown := min(base<<min(state.failures, 6), MAX_DEGRADED_COOLDOWN)
+		cooldown += time.Duration(rand.Int64N(int64(cooldown)*2/5+1)) - cooldown/5
+		state.failures++
+		state.until = now.Add(cooldown)
+		state.mu.Unlock()
+
+		rest.logger().Warn("discord api is degraded, pausing requests", "status", restErr.StatusCode, "cooldown", cooldown)
+		if first && rest.OnAPIDegraded != nil {
+			rest.OnAPIDegraded(restErr)
+		}
+		return
+	}
+
+	if state.since.IsZero() {
+		state.mu.Unlock()
+		return
+	}
+
+	downtime := time.Since(state.since)
+	state.since, state.until, state.failures = time.Time{}, time.Time{}, 0
+	state.mu.Unlock()
+
+	rest.logger().Info("discord api recovered", "downtime", downtime)
+	if rest.OnAPIRecovered != nil {
+		rest.OnAPIRecovered(downtime)
+	}
+}
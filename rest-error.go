@@ -0,0 +1,149 @@
+package tempest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// https://discord.com/developers/docs/topics/opcodes-and-status-codes#json-json-error-codes
+type ErrorCode uint32
+
+const (
+	GENERAL_ERROR_CODE                          ErrorCode = 0
+	UNKNOWN_ACCOUNT_ERROR_CODE                  ErrorCode = 10001
+	UNKNOWN_APPLICATION_ERROR_CODE              ErrorCode = 10002
+	UNKNOWN_CHANNEL_ERROR_CODE                  ErrorCode = 10003
+	UNKNOWN_GUILD_ERROR_CODE                    ErrorCode = 10004
+	UNKNOWN_INTEGRATION_ERROR_CODE              ErrorCode = 10005
+	UNKNOWN_INVITE_ERROR_CODE                   ErrorCode = 10006
+	UNKNOWN_MEMBER_ERROR_CODE                   ErrorCode = 10007
+	UNKNOWN_MESSAGE_ERROR_CODE                  ErrorCode = 10008
+	UNKNOWN_PERMISSION_OVERWRITE_ERROR_CODE     ErrorCode = 10009
+	UNKNOWN_ROLE_ERROR_CODE                     ErrorCode = 10011
+	UNKNOWN_TOKEN_ERROR_CODE                    ErrorCode = 10012
+	UNKNOWN_USER_ERROR_CODE                     ErrorCode = 10013
+	UNKNOWN_EMOJI_ERROR_CODE                    ErrorCode = 10014
+	UNKNOWN_WEBHOOK_ERROR_CODE                  ErrorCode = 10015
+	UNKNOWN_BAN_ERROR_CODE                      ErrorCode = 10026
+	UNKNOWN_SKU_ERROR_CODE                      ErrorCode = 10027
+	UNKNOWN_ENTITLEMENT_ERROR_CODE              ErrorCode = 10029
+	UNKNOWN_INTERACTION_ERROR_CODE              ErrorCode = 10062
+	UNKNOWN_APPLICATION_COMMAND_ERROR_CODE      ErrorCode = 10063
+	UNKNOWN_VOICE_STATE_ERROR_CODE              ErrorCode = 10065
+	BOTS_CANNOT_USE_ENDPOINT_ERROR_CODE         ErrorCode = 20001
+	ONLY_BOTS_CAN_USE_ENDPOINT_ERROR_CODE       ErrorCode = 20002
+	MAX_ROLES_REACHED_ERROR_CODE                ErrorCode = 30005
+	MAX_WEBHOOKS_REACHED_ERROR_CODE             ErrorCode = 30007
+	MAX_REACTIONS_REACHED_ERROR_CODE            ErrorCode = 30010
+	MAX_DAILY_COMMAND_CREATES_ERROR_CODE        ErrorCode = 30034
+	UNAUTHORIZED_ERROR_CODE                     ErrorCode = 40001 // Provide a valid token and try again.
+	REQUEST_ENTITY_TOO_LARGE_ERROR_CODE         ErrorCode = 40005
+	INTERACTION_ALREADY_ACKNOWLEDGED_ERROR_CODE ErrorCode = 40060
+	MISSING_ACCESS_ERROR_CODE                   ErrorCode = 50001
+	CANNOT_EDIT_FOREIGN_MESSAGE_ERROR_CODE      ErrorCode = 50005 // Cannot edit a message authored by another user.
+	CANNOT_SEND_EMPTY_MESSAGE_ERROR_CODE        ErrorCode = 50006
+	CANNOT_SEND_MESSAGES_TO_USER_ERROR_CODE     ErrorCode = 50007 // Usually means user has disabled DMs or blocked the bot.
+	MISSING_PERMISSIONS_ERROR_CODE              ErrorCode = 50013
+	INVALID_WEBHOOK_TOKEN_ERROR_CODE            ErrorCode = 50027
+	MESSAGE_TOO_OLD_TO_BULK_DELETE_ERROR_CODE   ErrorCode = 50034
+	INVALID_FORM_BODY_ERROR_CODE                ErrorCode = 50035
+	THREAD_ARCHIVED_ERROR_CODE                  ErrorCode = 50083
+	REACTION_BLOCKED_ERROR_CODE                 ErrorCode = 90001
+	RESOURCE_OVERLOADED_ERROR_CODE              ErrorCode = 130000
+)
+
+// Returned (wrapped together with RestError) whenever Discord API rejects bot token.
+var ErrInvalidToken = errors.New("discord api rejected bot token (it's either invalid or was reset)")
+
+// Returned by interaction helpers (edits & follow-ups) once interaction token is no longer valid, check Interaction.ExpiresAt.
+var ErrInteractionExpired = errors.New("interaction token has expired")
+
+// Returned without sending request while Discord API is unavailable (check Rest.DegradedCooldown).
+var ErrAPIDegraded = errors.New("discord api is unavailable, request was not sent")
+
+// RestError is returned by Rest client whenever Discord API responds with non 2xx status code.
+// Use errors.As to access it or one of helper predicates like IsUnknownMessage.
+//
+// https://discord.com/developers/docs/reference#error-messages
+type RestError struct {
+	Method     string          `json:"-"`
+	Route      string          `json:"-"` // Interaction & webhook tokens are redacted, so error can be logged safely.
+	StatusCode int             `json:"-"`
+	Status     string          `json:"-"`
+	Code       ErrorCode       `json:"code"`
+	Message    string          `json:"message"`
+	Errors     json.RawMessage `json:"errors,omitempty"` // Detailed, nested list of problems - mostly used with INVALID_FORM_BODY_ERROR_CODE.
+	Body       []byte          `json:"-"`                // Raw response body.
+	RateLimit  *RateLimitInfo  `json:"-"`                // Rate limit state reported with response, if any.
+}
+
+func newRestError(method, route string, statusCode int, status string, body []byte) *RestError {
+	err := &RestError{
+		Method:     method,
+		Route:      redactRoute(route),
+		StatusCode: statusCode,
+		Status:     status,
+		Body:       body,
+	}
+
+	_ = json.Unmarshal(body, err) // Body may not be JSON (for example with Cloudflare errors), status code is still enough.
+	return err
+}
+
+func (err *RestError) Error() string {
+	return fmt.Sprintf("%s :: %s", err.Status, string(err.Body))
+}
+
+// Returns true if err (or any error it wraps) is RestError with one of provided codes.
+func HasErrorCode(err error, codes ...ErrorCode) bool {
+	var restErr *RestError
+	if !errors.As(err, &restErr) {
+		return false
+	}
+
+	for _, code := range codes {
+		if restErr.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns true if targeted message no longer exists (it was probably deleted).
+func IsUnknownMessage(err error) bool {
+	return HasErrorCode(err, UNKNOWN_MESSAGE_ERROR_CODE)
+}
+
+// Returns true if targeted interaction no longer exists (it was never acknowledged or its token has expired).
+func IsUnknownInteraction(err error) bool {
+	return HasErrorCode(err, UNKNOWN_INTERACTION_ERROR_CODE)
+}
+
+// Returns true if bot cannot send direct message to user (user disabled DMs from server members or blocked the bot).
+func IsCannotSendToUser(err error) bool {
+	return HasErrorCode(err, CANNOT_SEND_MESSAGES_TO_USER_ERROR_CODE)
+}
+
+// Returns true if bot lacks permissions to perform that action.
+func IsMissingPermissions(err error) bool {
+	return HasErrorCode(err, MISSING_PERMISSIONS_ERROR_CODE)
+}
+
+// Returns true if bot has no access to targeted resource (for example it cannot see channel).
+func IsMissingAccess(err error) bool {
+	return HasErrorCode(err, MISSING_ACCESS_ERROR_CODE)
+}
+
+// Returns true if interaction was already acknowledged (for example after replying twice).
+func IsAlreadyAcknowledged(err error) bool {
+	return HasErrorCode(err, INTERACTION_ALREADY_ACKNOWLEDGED_ERROR_CODE)
+}
+
+// Returns true if route is authorized with interaction token instead of bot token
+// (401 on those means that interaction token has expired, not that bot token is invalid).
+func isInteractionTokenRoute(route string) bool {
+	return strings.HasPrefix(route, "/webhooks/") || strings.HasPrefix(route, "/interactions/")
+}
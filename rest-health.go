@@ -0,0 +1,97 @@
+package tempest
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Length of window over which Rest.Health counts requests & failures.
+const REST_HEALTH_WINDOW = time.Minute
+
+// Snapshot of Discord API health as seen by Rest, check Rest.Health.
+type RestHealth struct {
+	Degraded      bool         `json:"degraded"`       // Whether requests currently fail fast with ErrAPIDegraded.
+	DegradedSince ISOTimestamp `json:"degraded_since"` // Zero while API is healthy.
+	Requests      uint64       `json:"requests"`       // Finished requests within last 1-2 REST_HEALTH_WINDOW, without ones cancelled by caller's context.
+	Failures      uint64       `json:"failures"`       // Requests that failed with 5xx status, network error or ran out of retries. Rejections like 404 or 403 aren't counted.
+	ErrorRate     float64      `json:"error_rate"`     // Failures divided by requests, 0 when there were no requests.
+}
+
+// Counts request outcomes in fixed windows, keeping previous window so rate doesn't reset to 0 right after window changes.
+type restStats struct {
+	mu           sync.Mutex
+	windowStart  time.Time
+	requests     uint64
+	failures     uint64
+	prevRequests uint64
+	prevFailures uint64
+}
+
+func (stats *restStats) rotate(now time.Time) {
+	elapsed := now.Sub(stats.windowStart)
+	if elapsed < REST_HEALTH_WINDOW {
+		return
+	}
+
+	if elapsed < REST_HEALTH_WINDOW*2 {
+		stats.prevRequests, stats.prevFailures = stats.requests, stats.failures
+	} else {
+		stats.prevRequests, stats.prevFailures = 0, 0
+	}
+
+	stats.requests, stats.failures = 0, 0
+	stats.windowStart = now
+}
+
+func (stats *restStats) record(failed bool) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	stats.rotate(time.Now())
+	stats.requests++
+	if failed {
+		stats.failures++
+	}
+}
+
+// Whether error means Discord API (or connection to it) misbehaved, rather than request being rejected.
+func isRestFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var restErr *RestError
+	if errors.As(err, &restErr) {
+		return restErr.StatusCode >= 500
+	}
+	return true
+}
+
+// Returns current health of Discord API - whether it's degraded and how many recent requests failed.
+// Useful for dashboards & alerts, it's also part of Client.Health report.
+func (rest *Rest) Health() RestHealth {
+	rest.degraded.mu.Lock()
+	since := rest.degraded.since
+	rest.degraded.mu.Unlock()
+
+	stats := &rest.stats
+	stats.mu.Lock()
+	stats.rotate(time.Now())
+	requests := stats.requests + stats.prevRequests
+	failures := stats.failures + stats.prevFailures
+	stats.mu.Unlock()
+
+	health := RestHealth{
+		Degraded:      !since.IsZero(),
+		DegradedSince: NewISOTimestamp(since),
+		Requests:      requests,
+		Failures:      failures,
+	}
+
+	if requests > 0 {
+		health.ErrorRate = float64(failures) / float64(requests)
+	}
+
+	return health
+}
@@ -0,0 +1,191 @@
+package tempest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RequestScheduler is an optional queue for Rest client that prevents thundering herds when many goroutines use client at once.
+// It serializes requests going to the same rate limit bucket, caps number of requests processed at the same time
+// and waits for bucket reset whenever Discord reports it as exhausted (based on X-RateLimit-* headers).
+//
+// https://discord.com/developers/docs/topics/rate-limits
+type RequestScheduler struct {
+	// Optional storage for state of exhausted buckets. Use shared one (like RedisStore) when multiple processes use the same bot token.
+	// Leave it nil to keep state in memory.
+	Store CacheStore
+
+	slots   chan struct{}
+	buckets *SharedMap[string, *rateLimitBucket]
+	depth   atomic.Int64
+}
+
+type rateLimitBucket struct {
+	lock    chan struct{} // Held for the whole duration of request so requests within bucket are serialized.
+	stateMu sync.Mutex
+	resetAt time.Time
+	key     string
+	store   CacheStore
+}
+
+// Creates new scheduler that allows up to maxConcurrency requests to be processed at the same time.
+// Use 0 to not limit global concurrency and only serialize requests per bucket.
+func NewRequestScheduler(maxConcurrency uint16) *RequestScheduler {
+	scheduler := &RequestScheduler{
+		buckets: NewSharedMap[string, *rateLimitBucket](),
+	}
+
+	if maxConcurrency != 0 {
+		scheduler.slots = make(chan struct{}, maxConcurrency)
+	}
+
+	return scheduler
+}
+
+// Returns number of requests that are currently waiting in queue (for their bucket or free slot).
+func (scheduler *RequestScheduler) QueueDepth() int {
+	return int(scheduler.depth.Load())
+}
+
+// Blocks until request can be processed or context is done. Returned bucket has to be released once request is finished.
+func (scheduler *RequestScheduler) acquire(ctx context.Context, method, route string) (*rateLimitBucket, error) {
+	key := routeBucket(method, route)
+
+	scheduler.buckets.mu.Lock()
+	bucket, ok := scheduler.buckets.cache[key]
+	if !ok {
+		bucket = &rateLimitBucket{lock: make(chan struct{}, 1), key: key, store: scheduler.Store}
+		scheduler.buckets.cache[key] = bucket
+	}
+	scheduler.buckets.mu.Unlock()
+
+	scheduler.depth.Add(1)
+	defer scheduler.depth.Add(-1)
+
+	select {
+	case bucket.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if scheduler.slots != nil {
+		select {
+		case scheduler.slots <- struct{}{}:
+		case <-ctx.Done():
+			<-bucket.lock
+			return nil, ctx.Err()
+		}
+	}
+
+	return bucket, nil
+}
+
+func (scheduler *RequestScheduler) release(bucket *rateLimitBucket) {
+	if scheduler.slots != nil {
+		<-scheduler.slots
+	}
+	<-bucket.lock
+}
+
+// Sleeps until bucket is no longer exhausted (or context is done) & returns how long it waited.
+func (bucket *rateLimitBucket) wait(ctx context.Context) (time.Duration, error) {
+	bucket.stateMu.Lock()
+	resetAt := bucket.resetAt
+	bucket.stateMu.Unlock()
+
+	if bucket.store != nil {
+		if raw, ok := bucket.store.Get("ratelimit:" + bucket.key); ok {
+			if unixMilli, err := strconv.ParseInt(string(raw), 10, 64); err == nil {
+				resetAt = time.UnixMilli(unixMilli)
+			}
+		}
+	}
+
+	if sleepFor := time.Until(resetAt); sleepFor > 0 {
+		return sleepFor, sleepContext(ctx, sleepFor)
+	}
+
+	return 0, nil
+}
+
+func (bucket *rateLimitBucket) lockFor(duration time.Duration) {
+	resetAt := time.Now().Add(duration)
+
+	bucket.stateMu.Lock()
+	bucket.resetAt = resetAt
+	bucket.stateMu.Unlock()
+
+	if bucket.store != nil {
+		bucket.store.Set("ratelimit:"+bucket.key, strconv.AppendInt(nil, resetAt.UnixMilli(), 10), duration)
+	}
+}
+
+// Reads rate limit headers attached to every Discord API response.
+func (bucket *rateLimitBucket) update(header http.Header) {
+	if header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+
+	resetAfter, err := strconv.ParseFloat(header.Get("X-RateLimit-Reset-After"), 64)
+	if err != nil {
+		return
+	}
+
+	bucket.lockFor(time.Duration(resetAfter * float64(time.Second)))
+}
+
+// Returns key of rate limit bucket that route belongs to.
+// Discord shares limits per major parameter (channel, guild or webhook) so they are kept while all other IDs are replaced with placeholders.
+func routeBucket(method, route string) string {
+	return method + " " + routeTemplate(route, true)
+}
+
+// Converts route into its generic form by replacing IDs & tokens with placeholders,
+// for example "/channels/123/messages/456" becomes "/channels/:id/messages/:id".
+func routeTemplate(route string, keepMajor bool) string {
+	if i := strings.IndexByte(route, '?'); i != -1 {
+		route = route[:i]
+	}
+
+	original := strings.Split(route, "/")
+	parts := make([]string, len(original))
+	copy(parts, original)
+
+	for i := 1; i < len(original); i++ {
+		switch prev := original[i-1]; {
+		case prev == "reactions":
+			parts[i] = ":emoji"
+		case (prev == "channels" || prev == "guilds" || prev == "webhooks") && isNumericID(original[i]):
+			if !keepMajor {
+				parts[i] = ":id"
+			}
+		case i > 1 && (original[i-2] == "webhooks" || original[i-2] == "interactions") && isNumericID(prev):
+			if !keepMajor {
+				parts[i] = ":token"
+			}
+		case isNumericID(original[i]):
+			parts[i] = ":id"
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func isNumericID(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
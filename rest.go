@@ -2,13 +2,17 @@ package tempest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,15 +21,75 @@ import (
 type Rest struct {
 	HTTPClient http.Client
 	MaxRetries uint8
-	token      string
-	mu         sync.RWMutex
-	lockedTo   time.Time
+	Scheduler  *RequestScheduler // Optional queue for outgoing requests. Leave it nil to send each request right away.
+	Logger     *slog.Logger      // Optional logger for debug information about requests, rate limits & retries.
+	Metrics    Metrics           // Optional receiver of request & rate limit measurements.
+	Debug      *DebugRecorder    // Optional recorder of (redacted) request & response pairs, check NewDebugRecorder.
+	BaseURL    string            // Optional API URL without version, like "http://localhost:8080/api" for nirn-proxy or canary endpoint. Defaults to DISCORD_API_BASE_URL.
+	APIVersion uint8             // Optional API version, defaults to DISCORD_API_VERSION.
+
+	// Whether requests go through rate limit aware proxy (like nirn-proxy). Local rate limiting is skipped then - Scheduler is bypassed
+	// and 429 responses only delay retry of that single request instead of locking whole client.
+	ProxyMode bool
+
+	// Optional store for ETag/Last-Modified validators & bodies of GET responses. When set, GET requests are sent with
+	// If-None-Match/If-Modified-Since headers and cached body is returned on 304 Not Modified. Streamed requests skip it.
+	// Entries are kept for ConditionalTTL (defaults to 1 hour).
+	ConditionalStore CacheStore
+	ConditionalTTL   time.Duration
+
+	// Whether concurrent GET requests to the same route (like many Client.FetchUser calls with the same ID during interaction burst)
+	// should share single HTTP request. Only the first caller gets ResultInfo filled (check WithResultInfo).
+	CoalesceRequests bool
+
+	// Optional timeouts per route family. Keys are route prefixes with IDs replaced by ":id" (like "/channels/:id/messages" or "/guilds"),
+	// the longest matching prefix wins. Routes without match use RequestTimeout. Set it before sending any requests.
+	// Timeout of single request can be overridden with WithRequestTimeout.
+	Timeouts       map[string]time.Duration
+	RequestTimeout time.Duration // Optional timeout for requests without files that don't match any of Timeouts. HTTPClient.Timeout still applies on top of it.
+	UploadTimeout  time.Duration // Optional timeout for requests with attached files, takes priority over Timeouts & RequestTimeout.
+
+	// Optional function called whenever Discord API rejects bot token (401 Unauthorized).
+	// It's a fatal configuration error (token was reset or is invalid) so such requests are never retried.
+	UnauthorizedHandler func(err *RestError)
+
+	// Discord API responding with 502/503/504 (like during maintenance) is treated as outage - requests fail fast
+	// with ErrAPIDegraded for cooldown (starting at DegradedCooldown, default 5s, growing up to 5 minutes), after which
+	// single request probes whether API is back. Optional hooks are called once outage starts and once it ends.
+	DegradedCooldown time.Duration
+	OnAPIDegraded    func(err *RestError)
+	OnAPIRecovered   func(downtime time.Duration)
+
+	token         string
+	authMode      AuthMode
+	tokenProvider TokenProvider
+	mu            sync.RWMutex
+	lockedTo      time.Time
+	degraded      degradedState
+	stats         restStats
+	inflight      requestGroup
+
+	hookMu        sync.RWMutex
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
 }
 
+// Function that runs right before request is sent to Discord API.
+// It receives final request so it can be used to add custom headers (like tracing ones), log or mutate it.
+type RequestHook func(req *http.Request)
+
+// Function that runs after receiving response from Discord API.
+// Response body is already consumed & closed at this point - use it for logging or collecting metrics.
+type ResponseHook func(req *http.Request, res *http.Response, latency time.Duration)
+
 // Represents file you can attach to message on Discord.
 type File struct {
 	Name   string // File's display name
 	Reader io.Reader
+
+	// Optional function called as file is being uploaded, with total number of bytes sent so far.
+	// It may be called again from 0 if request is retried (only possible when Reader implements io.Seeker).
+	Progress func(sent int64)
 }
 
 type rateLimitError struct {
@@ -34,173 +98,600 @@ type rateLimitError struct {
 	Global     bool    `json:"global"`
 }
 
+// Decides how Rest authorizes requests.
+type AuthMode uint8
+
+const (
+	BOT_AUTH_MODE    AuthMode = iota // Uses bot token, "Bot " prefix is added automatically (default mode).
+	BEARER_AUTH_MODE                 // Uses OAuth2 access token, "Bearer " prefix is added automatically.
+	NO_AUTH_MODE                     // Sends no Authorization header, for endpoints authorized by URL alone (like webhooks with token).
+)
+
+// Function that returns current token, for example from secret store that rotates credentials.
+// Returned token doesn't need to include auth mode prefix.
+type TokenProvider func(ctx context.Context) (string, error)
+
 func NewRest(token string) *Rest {
-	t := token
-	if !strings.HasPrefix(t, "Bot ") {
-		t = "Bot " + t
-	}
+	return NewRestWithAuth(BOT_AUTH_MODE, token)
+}
+
+// Creates Rest that authorizes requests with OAuth2 access token (as "Bearer" token) instead of bot token.
+// Use it to call API on behalf of user, like "/users/@me/guilds", see oauth2 package.
+func NewBearerRest(accessToken string) *Rest {
+	return NewRestWithAuth(BEARER_AUTH_MODE, accessToken)
+}
 
+// Creates Rest with explicit auth mode. Token is ignored in NO_AUTH_MODE.
+func NewRestWithAuth(mode AuthMode, token string) *Rest {
 	return &Rest{
 		HTTPClient: *http.DefaultClient,
-		token:      t,
 		MaxRetries: 3,
+		authMode:   mode,
+		token:      mode.authorization(token),
 		lockedTo:   time.Time{},
 	}
 }
 
-func (rest *Rest) Request(method, route string, jsonPayload any) ([]byte, error) {
-	var body io.Reader
+// Creates Rest that asks provider for token before each request, so credentials can be rotated without restarting app.
+func NewRestWithTokenProvider(mode AuthMode, provider TokenProvider) *Rest {
+	rest := NewRestWithAuth(mode, "")
+	rest.tokenProvider = provider
+	return rest
+}
 
-	if jsonPayload != nil {
-		var buf bytes.Buffer
+// Returns value of Authorization header for given token, or empty string in NO_AUTH_MODE.
+func (mode AuthMode) authorization(token string) string {
+	var prefix string
+	switch mode {
+	case BOT_AUTH_MODE:
+		prefix = "Bot "
+	case BEARER_AUTH_MODE:
+		prefix = "Bearer "
+	default:
+		return ""
+	}
 
-		encoder := json.NewEncoder(&buf)
-		encoder.SetEscapeHTML(false)
-		if err := encoder.Encode(jsonPayload); err != nil {
-			return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
-		}
+	if token == "" || strings.HasPrefix(token, prefix) {
+		return token
+	}
+	return prefix + token
+}
 
-		body = &buf
+// Returns value of Authorization header for next request.
+func (rest *Rest) authorization(ctx context.Context) (string, error) {
+	if rest.tokenProvider == nil || rest.authMode == NO_AUTH_MODE {
+		return rest.token, nil
 	}
 
-	rest.mu.RLock()
-	lockedUntil := rest.lockedTo
-	rest.mu.RUnlock()
+	token, err := rest.tokenProvider(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain token: %w", err)
+	}
 
-	if !lockedUntil.IsZero() {
-		sleepFor := time.Until(lockedUntil)
-		if sleepFor > 0 {
-			time.Sleep(sleepFor)
-		}
+	return rest.authMode.authorization(token), nil
+}
+
+// Appends hooks to the chain of functions called before each request. Hooks run in order they were added.
+func (rest *Rest) OnRequest(hooks ...RequestHook) {
+	rest.hookMu.Lock()
+	rest.requestHooks = append(rest.requestHooks, hooks...)
+	rest.hookMu.Unlock()
+}
+
+// Appends hooks to the chain of functions called after each received response. Hooks run in order they were added.
+func (rest *Rest) OnResponse(hooks ...ResponseHook) {
+	rest.hookMu.Lock()
+	rest.responseHooks = append(rest.responseHooks, hooks...)
+	rest.hookMu.Unlock()
+}
+
+func (rest *Rest) Request(method, route string, jsonPayload any) ([]byte, error) {
+	return rest.RequestWithContext(context.Background(), method, route, jsonPayload)
+}
+
+// Works like Rest.Request but stops waiting (for rate limits, retries or response) once context is done.
+// Context values (like tracing spans) are available to request hooks through http.Request.Context.
+func (rest *Rest) RequestWithContext(ctx context.Context, method, route string, jsonPayload any) ([]byte, error) {
+	payload, err := encodeJSONPayload(jsonPayload)
+	if err != nil {
+		return nil, err
 	}
 
-	var i uint8
-	for i = 0; i < rest.MaxRetries; i++ {
-		rest.mu.RLock()
-		res, err, done := rest.handleRequest(method, route, body, CONTENT_TYPE_JSON)
-		rest.mu.RUnlock()
+	if rest.CoalesceRequests && method == http.MethodGet && jsonPayload == nil {
+		return rest.inflight.do(ctx, route, func(ctx context.Context) ([]byte, error) {
+			return rest.send(ctx, method, route, CONTENT_TYPE_JSON, payload, nil)
+		})
+	}
 
-		if done {
-			return res, err
-		}
+	return rest.send(ctx, method, route, CONTENT_TYPE_JSON, payload, nil)
+}
 
-		time.Sleep(time.Millisecond * time.Duration(250*(i+1)))
+// Works like Rest.Request but instead of buffering whole response, it passes successful response body to decode function.
+// Use it for endpoints that can return large payloads (member lists, message history) to keep memory usage low, see DecodeJSONArray.
+// Requests are not retried once decode function was called.
+func (rest *Rest) RequestStream(method, route string, jsonPayload any, decode func(body io.Reader) error) error {
+	payload, err := encodeJSONPayload(jsonPayload)
+	if err != nil {
+		return err
 	}
 
-	return nil, fmt.Errorf("request failed after %d retries to %s %s", rest.MaxRetries, method, route)
+	_, err = rest.send(context.Background(), method, route, CONTENT_TYPE_JSON, payload, decode)
+	return err
+}
+
+// Encodes JSON payload & returns function that creates fresh body reader for each attempt.
+func encodeJSONPayload(jsonPayload any) (func() (io.Reader, error), error) {
+	var payload []byte
+
+	if jsonPayload != nil {
+		var err error
+		if payload, err = marshalJSON(jsonPayload); err != nil {
+			return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+		}
+	}
+
+	return func() (io.Reader, error) {
+		if payload == nil {
+			return nil, nil
+		}
+		return bytes.NewReader(payload), nil
+	}, nil
 }
 
 func (rest *Rest) RequestWithFiles(method string, route string, jsonPayload any, files []File) ([]byte, error) {
+	return rest.RequestWithFilesContext(context.Background(), method, route, jsonPayload, files)
+}
+
+// Works like Rest.RequestWithFiles but stops waiting (for rate limits, retries or response) once context is done.
+func (rest *Rest) RequestWithFilesContext(ctx context.Context, method string, route string, jsonPayload any, files []File) ([]byte, error) {
 	if len(files) == 0 {
-		return rest.Request(method, route, jsonPayload)
+		return rest.RequestWithContext(ctx, method, route, jsonPayload)
 	}
 
-	rest.mu.RLock()
-	lockedUntil := rest.lockedTo
-	rest.mu.RUnlock()
+	// Multipart body is streamed through pipe, so files are never fully buffered in memory.
+	// Every attempt gets fresh pipe - retries are only possible if all file readers can be rewound.
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	var produced chan struct{}
 
-	if !lockedUntil.IsZero() {
-		sleepFor := time.Until(lockedUntil)
-		if sleepFor > 0 {
-			time.Sleep(sleepFor)
+	return rest.send(ctx, method, route, "multipart/form-data; boundary="+boundary, func() (io.Reader, error) {
+		if produced != nil {
+			<-produced // Producer of previous attempt may still be reading files.
+			if err := rewindFiles(files); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	// Prepare pipe for streaming multipart content without full buffering
-	pr, pw := io.Pipe()
+		done := make(chan struct{})
+		produced = done
+
+		pr, pw := io.Pipe()
+		go func() {
+			defer close(done)
+			writeMultipart(pw, boundary, jsonPayload, files)
+		}()
+		return pr, nil
+	}, nil)
+}
+
+func writeMultipart(pw *io.PipeWriter, boundary string, jsonPayload any, files []File) {
 	writer := multipart.NewWriter(pw)
+	writer.SetBoundary(boundary)
 
-	go func() {
-		defer pw.Close()
-		defer writer.Close()
+	jsonPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": []string{CONTENT_MULTIPART_JSON_DESCRIPTION},
+		"Content-Type":        []string{CONTENT_TYPE_JSON},
+	})
+	if err != nil {
+		pw.CloseWithError(fmt.Errorf("failed to create payload_json part: %w", err))
+		return
+	}
 
-		jsonPart, err := writer.CreatePart(textproto.MIMEHeader{
-			"Content-Disposition": []string{CONTENT_MULTIPART_JSON_DESCRIPTION},
-			"Content-Type":        []string{CONTENT_TYPE_JSON},
+	body, err := marshalJSON(jsonPayload)
+	if err != nil {
+		pw.CloseWithError(fmt.Errorf("failed to encode payload_json: %w", err))
+		return
+	}
+
+	if _, err := jsonPart.Write(body); err != nil {
+		pw.CloseWithError(err)
+		return
+	}
+
+	for i, file := range files {
+		filePart, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": []string{fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, file.Name)},
+			"Content-Type":        []string{CONTENT_TYPE_OCTET_STREAM},
 		})
+
 		if err != nil {
-			pw.CloseWithError(fmt.Errorf("failed to create payload_json part: %w", err))
+			pw.CloseWithError(fmt.Errorf("failed to create file part [%d]: %w", i, err))
 			return
 		}
 
-		encoder := json.NewEncoder(jsonPart)
-		encoder.SetEscapeHTML(false)
+		var reader io.Reader = file.Reader
+		if file.Progress != nil {
+			reader = &progressReader{reader: file.Reader, progress: file.Progress}
+		}
 
-		if err := encoder.Encode(jsonPayload); err != nil {
-			pw.CloseWithError(fmt.Errorf("failed to encode payload_json: %w", err))
+		if _, err := io.Copy(filePart, reader); err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to stream file [%s]: %w", file.Name, err))
 			return
 		}
+	}
 
-		for i, file := range files {
-			filePart, err := writer.CreatePart(textproto.MIMEHeader{
-				"Content-Disposition": []string{fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, file.Name)},
-				"Content-Type":        []string{CONTENT_TYPE_OCTET_STREAM},
-			})
+	pw.CloseWithError(writer.Close())
+}
 
-			if err != nil {
-				pw.CloseWithError(fmt.Errorf("failed to create file part [%d]: %w", i, err))
-				return
-			}
+// Moves all file readers back to start, so multipart body can be produced again.
+func rewindFiles(files []File) error {
+	for _, file := range files {
+		seeker, ok := file.Reader.(io.Seeker)
+		if !ok {
+			return fmt.Errorf("cannot retry upload - file %q reader is not seekable", file.Name)
+		}
 
-			if _, err := io.Copy(filePart, file.Reader); err != nil {
-				pw.CloseWithError(fmt.Errorf("failed to stream file [%s]: %w", file.Name, err))
-				return
-			}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to rewind file %q: %w", file.Name, err)
 		}
-	}()
+	}
+	return nil
+}
+
+type progressReader struct {
+	reader   io.Reader
+	progress func(sent int64)
+	sent     int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.sent)
+	}
+	return n, err
+}
+
+// Sends request through scheduler (if enabled) & retries it up to Rest.MaxRetries times.
+// Payload function is called once per attempt to get fresh body reader, its error stops retrying.
+// If decode function is provided, successful response body is streamed to it instead of being returned.
+func (rest *Rest) send(ctx context.Context, method, route, contentType string, payload func() (io.Reader, error), decode func(body io.Reader) error) ([]byte, error) {
+	var bucket *rateLimitBucket
+	if rest.Scheduler != nil && !rest.ProxyMode {
+		var err error
+		if bucket, err = rest.Scheduler.acquire(ctx, method, route); err != nil {
+			return nil, fmt.Errorf("request to %s %s cancelled: %w", method, redactRoute(route), err)
+		}
+		defer rest.Scheduler.release(bucket)
+	}
 
 	var i uint8
 	for i = 0; i < rest.MaxRetries; i++ {
-		rest.mu.RLock()
-		body, err, done := rest.handleRequest(method, route, pr, writer.FormDataContentType())
-		rest.mu.RUnlock()
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("request to %s %s cancelled: %w", method, redactRoute(route), err)
+		}
+
+		var waited time.Duration
+		var err error
+		if !rest.ProxyMode {
+			waited, err = rest.waitForGlobalRateLimit(ctx)
+		}
+
+		if bucket != nil && err == nil {
+			var bucketWaited time.Duration
+			bucketWaited, err = bucket.wait(ctx)
+			waited += bucketWaited
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("request to %s %s cancelled: %w", method, redactRoute(route), err)
+		}
+
+		if waited > 0 {
+			rest.metrics().ObserveRateLimitWait(routeTemplate(route, false), waited)
+		}
+
+		probe, err := rest.admitRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := payload()
+		if err != nil {
+			if probe {
+				rest.cancelProbe()
+			}
+			return nil, err
+		}
+
+		res, err, done := rest.handleRequest(ctx, method, route, body, contentType, bucket, decode)
+		if closer, ok := body.(io.Closer); ok {
+			closer.Close() // Stops multipart producer if request failed before reading whole body.
+		}
 
 		if done {
-			return body, err
+			rest.reportHealth(probe, err)
+			if ctx.Err() == nil { // Caller giving up says nothing about API health.
+				rest.stats.record(isRestFailure(err))
+			}
+			return res, err
 		}
 
-		time.Sleep(time.Millisecond * time.Duration(250*(i+1)))
+		if probe {
+			rest.cancelProbe()
+		}
+
+		rest.logger().Debug("retrying request", "method", method, "route", routeTemplate(route, false), "attempt", i+1, "error", stripRequestURL(err))
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Millisecond * time.Duration(250*(i+1))):
+		}
+	}
+
+	rest.stats.record(true)
+	return nil, fmt.Errorf("request failed after %d retries to %s %s", rest.MaxRetries, method, redactRoute(route))
+}
+
+// Returns full URL of given API route, respecting Rest.BaseURL & Rest.APIVersion.
+func (rest *Rest) URL(route string) string {
+	if rest.BaseURL == "" && rest.APIVersion == 0 {
+		return DISCORD_API_URL + route
+	}
+
+	baseURL := strings.TrimSuffix(rest.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = DISCORD_API_BASE_URL
+	}
+
+	version := rest.APIVersion
+	if version == 0 {
+		version = DISCORD_API_VERSION
+	}
+
+	return baseURL + "/v" + strconv.Itoa(int(version)) + route
+}
+
+// Returns provided logger or one that discards everything.
+func (rest *Rest) logger() *slog.Logger {
+	if rest.Logger == nil {
+		return discardLogger
+	}
+	return rest.Logger
+}
+
+// Returns provided metrics receiver or one that ignores everything.
+func (rest *Rest) metrics() Metrics {
+	if rest.Metrics == nil {
+		return NoopMetrics{}
+	}
+	return rest.Metrics
+}
+
+type requestTimeoutKey struct{}
+
+type auditLogReasonKey struct{}
+
+// Returns context that attaches reason (visible in guild's audit log) to requests made with it. Reason can have up to 512 characters.
+func WithAuditLogReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, auditLogReasonKey{}, reason)
+}
+
+// Returns context that overrides Rest timeouts (Timeouts, RequestTimeout & UploadTimeout) for requests made with it,
+// for example to give single large upload more time. Use 0 to disable them. Deadline of ctx itself is still respected.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// Returns timeout for given request, if any was configured.
+func (rest *Rest) timeoutFor(ctx context.Context, route string, contentType string) time.Duration {
+	if timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+
+	upload := strings.HasPrefix(contentType, "multipart/")
+	if upload && rest.UploadTimeout > 0 {
+		return rest.UploadTimeout
+	}
+
+	timeout := rest.RequestTimeout
+	if upload {
+		timeout = 0 // RequestTimeout is meant for regular JSON requests, uploads can take much longer.
+	}
+
+	if len(rest.Timeouts) == 0 {
+		return timeout
+	}
+
+	template := routeTemplate(route, false)
+	matched := -1
+	for prefix, value := range rest.Timeouts {
+		if len(prefix) > matched && strings.HasPrefix(template, prefix) {
+			timeout, matched = value, len(prefix)
+		}
+	}
+
+	return timeout
+}
+
+// Sleeps until global rate limit is gone (or context is done) & returns how long it waited.
+func (rest *Rest) waitForGlobalRateLimit(ctx context.Context) (time.Duration, error) {
+	rest.mu.RLock()
+	lockedUntil := rest.lockedTo
+	rest.mu.RUnlock()
+
+	if !lockedUntil.IsZero() {
+		sleepFor := time.Until(lockedUntil)
+		if sleepFor > 0 {
+			rest.logger().Debug("waiting for global rate limit", "duration", sleepFor)
+			return sleepFor, sleepContext(ctx, sleepFor)
+		}
 	}
 
-	return nil, fmt.Errorf("request failed after %d retries to %s %s", rest.MaxRetries, method, route)
+	return 0, nil
 }
 
-func (rest *Rest) handleRequest(method string, route string, payload io.Reader, contentType string) ([]byte, error, bool) {
-	req, err := http.NewRequest(method, DISCORD_API_URL+route, payload)
+// Sleeps for given duration, unless context is done earlier.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (rest *Rest) handleRequest(ctx context.Context, method string, route string, payload io.Reader, contentType string, bucket *rateLimitBucket, decode func(body io.Reader) error) ([]byte, error, bool) {
+	callerCtx := ctx // Waiting for rate limit isn't part of request, so it's not cut short by request timeout.
+	if timeout := rest.timeoutFor(ctx, route, contentType); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var debugEntry *DebugEntry
+	if rest.Debug != nil {
+		debugEntry = &DebugEntry{Time: time.Now(), Method: method, Route: route}
+		if payload != nil {
+			if contentType == CONTENT_TYPE_JSON {
+				raw, _ := io.ReadAll(payload)
+				payload = bytes.NewReader(raw)
+				debugEntry.RequestBody = string(raw)
+			} else {
+				debugEntry.RequestBody = "(multipart body omitted)"
+			}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rest.URL(route), payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize new request: %w", err), false
 	}
 
+	authorization, err := rest.authorization(ctx)
+	if err != nil {
+		return nil, err, false
+	}
+
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("User-Agent", USER_AGENT)
-	req.Header.Set("Authorization", rest.token)
+	if reason, ok := ctx.Value(auditLogReasonKey{}).(string); ok && reason != "" {
+		req.Header.Set("X-Audit-Log-Reason", url.PathEscape(reason))
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	rest.hookMu.RLock()
+	requestHooks, responseHooks := rest.requestHooks, rest.responseHooks
+	rest.hookMu.RUnlock()
 
+	var cached *conditionalEntry
+	if decode == nil {
+		cached = rest.applyConditional(req, route)
+	}
+
+	for _, hook := range requestHooks {
+		hook(req)
+	}
+
+	start := time.Now()
 	res, err := rest.HTTPClient.Do(req)
 	if err != nil {
+		rest.metrics().ObserveRequest(method, routeTemplate(route, false), 0, time.Since(start))
+		fillResultInfo(ctx, nil, time.Since(start))
+		if debugEntry != nil {
+			debugEntry.RequestHeaders = req.Header
+			debugEntry.Latency = time.Since(start)
+			rest.Debug.record(*debugEntry, err)
+		}
 		return nil, fmt.Errorf("failed to process request: %w", err), false
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode == http.StatusNoContent {
+	var body []byte
+	streamed := decode != nil && res.StatusCode >= 200 && res.StatusCode <= 299 && res.StatusCode != http.StatusNoContent
+	if streamed {
+		err = decode(res.Body)
+		io.Copy(io.Discard, res.Body) // Drain leftovers so connection can be reused.
+	} else {
+		body, err = io.ReadAll(res.Body)
+	}
+
+	res.Body.Close()
+	latency := time.Since(start)
+	rest.logger().Debug("received response", "method", method, "route", routeTemplate(route, false), "status", res.StatusCode, "latency", latency)
+	rest.metrics().ObserveRequest(method, routeTemplate(route, false), res.StatusCode, latency)
+	rateLimit := fillResultInfo(ctx, res, latency)
+
+	for _, hook := range responseHooks {
+		hook(req, res, latency)
+	}
+
+	if debugEntry != nil {
+		debugEntry.RequestHeaders = req.Header
+		debugEntry.Status = res.StatusCode
+		debugEntry.ResponseHeaders = res.Header
+		debugEntry.ResponseBody = string(body)
+		debugEntry.Latency = latency
+		if streamed {
+			debugEntry.ResponseBody = "(streamed body omitted)"
+		}
+		rest.Debug.record(*debugEntry, err)
+	}
+
+	if bucket != nil {
+		bucket.update(res.Header)
+	}
+
+	if streamed {
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response body: %w", err), true
+		}
 		return nil, nil, true
 	}
 
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err), true
 	}
 
+	if res.StatusCode == http.StatusNoContent {
+		return nil, nil, true
+	}
+
+	if cached != nil && res.StatusCode == http.StatusNotModified {
+		rest.logger().Debug("using cached response", "method", method, "route", routeTemplate(route, false))
+		return cached.Body, nil, true
+	}
+
 	if res.StatusCode == http.StatusTooManyRequests {
 		var rateErr rateLimitError
 		_ = json.Unmarshal(body, &rateErr) // even if this fails - it can still fall back
 
 		retryAfter := time.Second * time.Duration(rateErr.RetryAfter+5)
+		rest.logger().Debug("rate limited", "method", method, "route", routeTemplate(route, false), "global", rateErr.Global, "retry_after", retryAfter)
+
+		if bucket != nil && !rateErr.Global {
+			bucket.lockFor(retryAfter)
+			return nil, errors.New("rate limited"), false
+		}
+
+		if rest.ProxyMode {
+			if sleepContext(callerCtx, retryAfter) == nil {
+				rest.metrics().ObserveRateLimitWait(routeTemplate(route, false), retryAfter)
+			}
+			return nil, errors.New("rate limited"), false // Retry loop reports cancellation, if any.
+		}
 
 		rest.mu.Lock()
 		rest.lockedTo = time.Now().Add(retryAfter)
 		rest.mu.Unlock()
 
-		time.Sleep(retryAfter)
+		if sleepContext(callerCtx, retryAfter) != nil {
+			return nil, errors.New("rate limited"), false // Lock stays in place for other requests.
+		}
+		rest.metrics().ObserveRateLimitWait(routeTemplate(route, false), retryAfter)
 
 		rest.mu.Lock()
 		rest.lockedTo = time.Time{}
@@ -210,7 +701,21 @@ func (rest *Rest) handleRequest(method string, route string, payload io.Reader,
 	}
 
 	if res.StatusCode < 200 || res.StatusCode > 299 {
-		return nil, fmt.Errorf("%s :: %s", res.Status, string(body)), true
+		restErr := newRestError(method, route, res.StatusCode, res.Status, body)
+		restErr.RateLimit = rateLimit
+		if res.StatusCode == http.StatusUnauthorized && !isInteractionTokenRoute(route) {
+			rest.logger().Error("discord api rejected bot token", "method", method, "route", routeTemplate(route, false))
+			if rest.UnauthorizedHandler != nil {
+				rest.UnauthorizedHandler(restErr)
+			}
+			return nil, fmt.Errorf("%w: %w", ErrInvalidToken, restErr), true
+		}
+
+		return nil, restErr, true
+	}
+
+	if rest.ConditionalStore != nil && method == http.MethodGet && decode == nil {
+		rest.storeConditional(route, res.Header, body)
 	}
 
 	return body, nil, true
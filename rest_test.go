@@ -0,0 +1,77 @@
+package tempest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+	"github.com/amatsagu/tempest/test"
+)
+
+func TestRetryLogRedactsToken(t *testing.T) {
+	var logs bytes.Buffer
+	rest := tempest.NewRestWithAuth(tempest.NO_AUTH_MODE, "")
+	rest.HTTPClient.Transport = failingTransport{}
+	rest.MaxRetries = 1
+	rest.Logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	if _, err := rest.Request(http.MethodPost, "/webhooks/1144027356181467136/"+webhookToken, nil); err == nil {
+		t.Fatal("expected request to fail")
+	}
+
+	if !strings.Contains(logs.String(), "retrying request") {
+		t.Fatalf("expected retry to be logged, got %q", logs.String())
+	}
+
+	if strings.Contains(logs.String(), webhookToken) {
+		t.Fatalf("token leaked into logs: %q", logs.String())
+	}
+}
+
+func TestRestErrorRedactsToken(t *testing.T) {
+	mock := test.NewMockRest()
+	rest := tempest.NewRestWithAuth(tempest.NO_AUTH_MODE, "")
+	mock.Attach(rest)
+	mock.OnError(http.MethodPost, "/webhooks/*/*", http.StatusNotFound, tempest.UNKNOWN_WEBHOOK_ERROR_CODE)
+
+	_, err := rest.Request(http.MethodPost, "/webhooks/1144027356181467136/"+webhookToken, nil)
+	var restErr *tempest.RestError
+	if !errors.As(err, &restErr) {
+		t.Fatalf("expected RestError, got %v", err)
+	}
+
+	if strings.Contains(restErr.Route, webhookToken) {
+		t.Fatalf("token leaked into error route: %q", restErr.Route)
+	}
+}
+
+func TestRateLimitWaitRespectsContext(t *testing.T) {
+	for name, scheduler := range map[string]*tempest.RequestScheduler{"global": nil, "bucket": tempest.NewRequestScheduler(0)} {
+		t.Run(name, func(t *testing.T) {
+			mock := test.NewMockRest()
+			rest := tempest.NewRestWithAuth(tempest.NO_AUTH_MODE, "")
+			rest.Scheduler = scheduler
+			mock.Attach(rest)
+			mock.On(http.MethodPost, "/channels/*/messages", http.StatusTooManyRequests, map[string]any{"retry_after": 1, "global": scheduler == nil})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := rest.RequestWithContext(ctx, http.MethodPost, "/channels/1144027356181467136/messages", nil)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("expected deadline error, got %v", err)
+			}
+
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("request kept waiting for rate limit after context was done (%s)", elapsed)
+			}
+		})
+	}
+}
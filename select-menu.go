@@ -0,0 +1,76 @@
+package tempest
+
+// Returns default values that preselect given users. Use with user & mentionable select components.
+func DefaultUsers(ids ...Snowflake) []DefaultValueOption {
+	return defaultValues(USER_DEFAULT_VALUE, ids)
+}
+
+// Returns default values that preselect given roles. Use with role & mentionable select components.
+func DefaultRoles(ids ...Snowflake) []DefaultValueOption {
+	return defaultValues(ROLE_DEFAULT_VALUE, ids)
+}
+
+// Returns default values that preselect given channels. Use with channel select components.
+// Channels excluded by SelectComponent.ChannelTypes won't be shown as selected.
+func DefaultChannels(ids ...Snowflake) []DefaultValueOption {
+	return defaultValues(CHANNEL_DEFAULT_VALUE, ids)
+}
+
+func defaultValues(valueType DefaultValueType, ids []Snowflake) []DefaultValueOption {
+	values := make([]DefaultValueOption, len(ids))
+	for i, id := range ids {
+		values[i] = DefaultValueOption{ID: id, Type: valueType}
+	}
+	return values
+}
+
+// Returns IDs selected in user, role, mentionable or channel select component. Values that aren't valid snowflakes are skipped.
+func (itx ComponentInteraction) SelectedIDs() []Snowflake {
+	ids := make([]Snowflake, 0, len(itx.Data.Values))
+	for _, value := range itx.Data.Values {
+		if id, err := StringToSnowflake(value); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Returns IDs of users selected in user or mentionable select component.
+func (itx ComponentInteraction) SelectedUserIDs() []Snowflake {
+	return itx.selectedIDsOf(func(resolved *InteractionDataResolved, id Snowflake) bool {
+		_, ok := resolved.Users[id]
+		return ok
+	})
+}
+
+// Returns IDs of roles selected in role or mentionable select component.
+func (itx ComponentInteraction) SelectedRoleIDs() []Snowflake {
+	return itx.selectedIDsOf(func(resolved *InteractionDataResolved, id Snowflake) bool {
+		_, ok := resolved.Roles[id]
+		return ok
+	})
+}
+
+// Returns IDs of channels selected in channel select component.
+func (itx ComponentInteraction) SelectedChannelIDs() []Snowflake {
+	return itx.selectedIDsOf(func(resolved *InteractionDataResolved, id Snowflake) bool {
+		_, ok := resolved.Channels[id]
+		return ok
+	})
+}
+
+// Mentionable select sends users & roles in the same values list, so resolved data tells which one is which.
+func (itx ComponentInteraction) selectedIDsOf(match func(resolved *InteractionDataResolved, id Snowflake) bool) []Snowflake {
+	ids := itx.SelectedIDs()
+	if itx.Data.Resolved == nil {
+		return ids[:0]
+	}
+
+	filtered := ids[:0]
+	for _, id := range ids {
+		if match(itx.Data.Resolved, id) {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,100 @@
+package tempest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Counts running handlers so Client.Shutdown can wait for them.
+type lifecycle struct {
+	mu      sync.Mutex
+	closing bool
+	active  int
+	idle    chan struct{} // Closed once client is closing & no handler is running.
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{idle: make(chan struct{})}
+}
+
+// Registers new handler. Returns false if client is shutting down.
+func (lc *lifecycle) begin() bool {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if lc.closing {
+		return false
+	}
+
+	lc.active++
+	return true
+}
+
+func (lc *lifecycle) end() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	lc.active--
+	if lc.closing && lc.active == 0 {
+		close(lc.idle)
+	}
+}
+
+func (lc *lifecycle) close() <-chan struct{} {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if !lc.closing {
+		lc.closing = true
+		if lc.active == 0 {
+			close(lc.idle)
+		}
+	}
+
+	return lc.idle
+}
+
+// Whether Client.Shutdown was called. Useful for readiness probes, so load balancer stops routing traffic to this instance.
+func (client *Client) ShuttingDown() bool {
+	client.lifecycle.mu.Lock()
+	defer client.lifecycle.mu.Unlock()
+	return client.lifecycle.closing
+}
+
+// Gracefully stops client: new interactions & webhook events are rejected with 503 Service Unavailable,
+// then it waits for running handlers & scheduled tasks to finish and for Rest.Scheduler queue (if any) to drain. Loaded modules are unloaded at the end.
+// Returns context error if deadline passed before that. This library has no gateway connection, so there's nothing else to close.
+//
+// It doesn't stop your HTTP server - call http.Server.Shutdown afterwards.
+func (client *Client) Shutdown(ctx context.Context) error {
+	client.logger.Info("shutting down client")
+
+	select {
+	case <-client.lifecycle.close():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	client.events.stop()
+
+	if err := client.Scheduler.Stop(ctx); err != nil {
+		return err
+	}
+
+	if client.Rest.Scheduler != nil {
+		ticker := time.NewTicker(time.Millisecond * 50)
+		defer ticker.Stop()
+
+		for client.Rest.Scheduler.QueueDepth() > 0 {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	client.unloadModules()
+	return nil
+}
@@ -34,6 +34,10 @@ func (s Snowflake) MarshalJSON() ([]byte, error) {
 }
 
 func (s *Snowflake) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil // Discord sends null IDs for things like unicode emojis.
+	}
+
 	str, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -47,3 +51,35 @@ func (s *Snowflake) UnmarshalJSON(b []byte) error {
 	*s = Snowflake(i)
 	return nil
 }
+
+// Returns the smallest snowflake that could be generated at given time.
+func SnowflakeFromTime(t time.Time) Snowflake {
+	ms := t.UnixMilli() - DISCORD_EPOCH
+	if ms < 0 {
+		return 0
+	}
+
+	return Snowflake(uint64(ms) << 22)
+}
+
+// Returns min & max snowflakes that could be generated within given time window.
+// Use them as "after" and "before" cursors on paginated endpoints to fetch entries created in that window.
+func SnowflakeRange(from, to time.Time) (Snowflake, Snowflake) {
+	return SnowflakeFromTime(from), SnowflakeFromTime(to) | (1<<22 - 1)
+}
+
+// Returns creation time of snowflake truncated to given interval (for example time.Hour or 24 * time.Hour), in UTC.
+func (s Snowflake) TimeBucket(interval time.Duration) time.Time {
+	return s.CreationTimestamp().UTC().Truncate(interval)
+}
+
+// Groups entries (like messages or entitlements) by creation time of their snowflake, truncated to given interval.
+// Handy for analytics exports - use time.Hour to bucket per hour or 24 * time.Hour to bucket per day.
+func GroupBySnowflakeTime[T any](entries []T, id func(T) Snowflake, interval time.Duration) map[time.Time][]T {
+	res := make(map[time.Time][]T)
+	for _, entry := range entries {
+		key := id(entry).TimeBucket(interval)
+		res[key] = append(res[key], entry)
+	}
+	return res
+}
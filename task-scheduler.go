@@ -0,0 +1,354 @@
+package tempest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type TaskOptions struct {
+	Jitter   time.Duration  // Optional random delay (between 0 and Jitter) added before each run, spreads load when many replicas run the same task.
+	Timeout  time.Duration  // Optional deadline of context passed to each run.
+	Location *time.Location // Time zone of cron schedule, defaults to UTC. Ignored by interval tasks.
+}
+
+// TaskScheduler runs background tasks (like reminders or posting stats) on interval or cron schedule.
+// Runs of the same task never overlap, panics & errors are logged without stopping the task.
+// Client.Shutdown stops it, waiting for running tasks to finish.
+type TaskScheduler struct {
+	logger  *slog.Logger
+	mu      sync.Mutex
+	tasks   map[string]chan struct{} // Closed to cancel task.
+	stop    chan struct{}
+	stopped bool
+	wg      sync.WaitGroup
+	ctx     context.Context // Cancelled once Stop deadline passes, to interrupt running tasks.
+	cancel  context.CancelFunc
+}
+
+func NewTaskScheduler(logger *slog.Logger) *TaskScheduler {
+	if logger == nil {
+		logger = discardLogger
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TaskScheduler{
+		logger: logger,
+		tasks:  make(map[string]chan struct{}),
+		stop:   make(chan struct{}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Runs task every interval, counted from end of previous run. Task name has to be unique.
+func (scheduler *TaskScheduler) Every(name string, interval time.Duration, fn func(ctx context.Context) error, opt TaskOptions) error {
+	if interval <= 0 {
+		return errors.New("task interval has to be positive")
+	}
+
+	return scheduler.schedule(name, func(now time.Time) time.Time {
+		return now.Add(interval)
+	}, fn, opt)
+}
+
+// Runs task according to cron expression with 5 fields: minute, hour, day of month, month & day of week (0 = Sunday).
+// Fields support "*", lists ("1,15"), ranges ("1-5") and steps ("*/10" or "0-30/5"). Descriptors like "@hourly", "@daily",
+// "@weekly", "@monthly" & "@yearly" work too. For example "30 9 * * 1-5" runs task at 9:30 on weekdays.
+func (scheduler *TaskScheduler) Cron(name string, expression string, fn func(ctx context.Context) error, opt TaskOptions) error {
+	schedule, err := parseCron(expression)
+	if err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+
+	location := opt.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	return scheduler.schedule(name, func(now time.Time) time.Time {
+		return schedule.next(now.In(location))
+	}, fn, opt)
+}
+
+// Stops task with given name. Its current run (if any) isn't interrupted. Returns false if there's no such task.
+func (scheduler *TaskScheduler) Cancel(name string) bool {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	cancel, ok := scheduler.tasks[name]
+	if ok {
+		close(cancel)
+		delete(scheduler.tasks, name)
+	}
+	return ok
+}
+
+// Returns names of scheduled tasks.
+func (scheduler *TaskScheduler) Tasks() []string {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	names := make([]string, 0, len(scheduler.tasks))
+	for name := range scheduler.tasks {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Stops all tasks and waits for running ones to finish. Once context is done, contexts of running tasks are cancelled too.
+// Scheduler cannot be used after that.
+func (scheduler *TaskScheduler) Stop(ctx context.Context) error {
+	scheduler.mu.Lock()
+	if !scheduler.stopped {
+		scheduler.stopped = true
+		close(scheduler.stop)
+	}
+	scheduler.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		scheduler.cancel()
+		return nil
+	case <-ctx.Done():
+		scheduler.cancel()
+		return ctx.Err()
+	}
+}
+
+func (scheduler *TaskScheduler) schedule(name string, next func(now time.Time) time.Time, fn func(ctx context.Context) error, opt TaskOptions) error {
+	if fn == nil {
+		return errors.New("task function cannot be nil")
+	}
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	if scheduler.stopped {
+		return errors.New("task scheduler is already stopped")
+	}
+
+	if _, ok := scheduler.tasks[name]; ok {
+		return errors.New("task scheduler already has \"" + name + "\" task (name already in use)")
+	}
+
+	cancel := make(chan struct{})
+	scheduler.tasks[name] = cancel
+	scheduler.wg.Add(1)
+
+	go func() {
+		defer scheduler.wg.Done()
+
+		for {
+			at := next(time.Now())
+			if at.IsZero() {
+				scheduler.logger.Warn("task has no upcoming runs", "task", name)
+				return
+			}
+
+			delay := time.Until(at)
+			if opt.Jitter > 0 {
+				delay += rand.N(opt.Jitter)
+			}
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-cancel:
+				timer.Stop()
+				return
+			case <-scheduler.stop:
+				timer.Stop()
+				return
+			}
+
+			scheduler.run(name, fn, opt)
+		}
+	}()
+
+	return nil
+}
+
+func (scheduler *TaskScheduler) run(name string, fn func(ctx context.Context) error, opt TaskOptions) {
+	ctx := scheduler.ctx
+	if opt.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opt.Timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			scheduler.logger.Error("recovered panic in scheduled task", "task", name, "panic", r, "stack", string(debug.Stack()))
+		}
+	}()
+
+	start := time.Now()
+	if err := fn(ctx); err != nil {
+		scheduler.logger.Warn("scheduled task failed", "task", name, "error", err)
+		return
+	}
+	scheduler.logger.Debug("scheduled task finished", "task", name, "duration", time.Since(start))
+}
+
+// Parsed cron expression. Each field is a bit set of allowed values.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+func parseCron(expression string) (cronSchedule, error) {
+	if descriptor, ok := cronDescriptors[strings.TrimSpace(expression)]; ok {
+		expression = descriptor
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return cronSchedule{}, errors.New("expected 5 fields")
+	}
+
+	var schedule cronSchedule
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return cronSchedule{}, fmt.Errorf("minute: %w", err)
+	}
+
+	if schedule.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return cronSchedule{}, fmt.Errorf("hour: %w", err)
+	}
+
+	if schedule.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return cronSchedule{}, fmt.Errorf("day of month: %w", err)
+	}
+
+	if schedule.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return cronSchedule{}, fmt.Errorf("month: %w", err)
+	}
+
+	if schedule.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return cronSchedule{}, fmt.Errorf("day of week: %w", err)
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1 // Both 0 and 7 mean Sunday.
+	}
+
+	schedule.domAny = fields[2] == "*"
+	schedule.dowAny = fields[4] == "*"
+	return schedule, nil
+}
+
+func parseCronField(field string, min int, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepPart)
+			if err != nil || parsed <= 0 {
+				return 0, errors.New("invalid step \"" + stepPart + "\"")
+			}
+			step = parsed
+		}
+
+		from, to := min, max
+		if rangePart != "*" {
+			low, high, isRange := strings.Cut(rangePart, "-")
+			parsed, err := strconv.Atoi(low)
+			if err != nil {
+				return 0, errors.New("invalid value \"" + low + "\"")
+			}
+			from, to = parsed, parsed
+
+			if isRange {
+				if to, err = strconv.Atoi(high); err != nil {
+					return 0, errors.New("invalid value \"" + high + "\"")
+				}
+			} else if hasStep {
+				to = max
+			}
+		}
+
+		if from < min || to > max || from > to {
+			return 0, fmt.Errorf("value out of range %d-%d", min, max)
+		}
+
+		for value := from; value <= to; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+// Returns first matching minute after given time, or zero time if there's none within 5 years (like "0 0 31 2 *").
+func (schedule cronSchedule) next(now time.Time) time.Time {
+	t := now.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if schedule.month&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !schedule.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if schedule.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if schedule.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// Like in standard cron, if both day of month & day of week are restricted, matching either of them is enough.
+func (schedule cronSchedule) matchesDay(t time.Time) bool {
+	dom := schedule.dom&(1<<t.Day()) != 0
+	dow := schedule.dow&(1<<int(t.Weekday())) != 0
+
+	switch {
+	case schedule.domAny && schedule.dowAny:
+		return true
+	case schedule.domAny:
+		return dow
+	case schedule.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+// Payloads captured from Discord API (with IDs & tokens replaced), named after model they decode into.
+//
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Returns fixture with given name (like "interaction-command"), panics if there's no such fixture.
+func Fixture(name string) []byte {
+	data, err := fixtures.ReadFile("fixtures/" + name + ".json")
+	if err != nil {
+		panic("test: unknown fixture \"" + name + "\"")
+	}
+	return data
+}
+
+// Returns names of all fixtures.
+func FixtureNames() []string {
+	entries, _ := fs.ReadDir(fixtures, "fixtures")
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	return names
+}
+
+// Decodes every fixture into its model with tempest.UnmarshalStrict, so it fails if any field got lost on the way
+// (struct doesn't know it or can't encode it back). Call it from your own tests after upgrading tempest or refreshing fixtures:
+//
+//	if err := test.CheckFixtures(); err != nil {
+//		t.Fatal(err)
+//	}
+func CheckFixtures() error {
+	var errs []error
+	for _, name := range FixtureNames() {
+		if err := CheckFixture(name, Fixture(name)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Strictly decodes payload into model picked by fixture name prefix: "interaction" (including its data), "message", "member" or "channel".
+func CheckFixture(name string, payload []byte) error {
+	kind, _, _ := strings.Cut(name, "-")
+	switch kind {
+	case "interaction":
+		return checkInteraction(payload)
+	case "message":
+		return tempest.UnmarshalStrict(payload, &tempest.Message{})
+	case "member":
+		return tempest.UnmarshalStrict(payload, &tempest.Member{})
+	case "channel":
+		return tempest.UnmarshalStrict(payload, &tempest.Channel{})
+	}
+	return errors.New("no model for fixture \"" + name + "\"")
+}
+
+func checkInteraction(payload []byte) error {
+	var interaction tempest.Interaction
+	if err := tempest.UnmarshalStrict(payload, &interaction); err != nil {
+		return err
+	}
+
+	var data any
+	switch interaction.Type {
+	case tempest.APPLICATION_COMMAND_INTERACTION_TYPE, tempest.APPLICATION_COMMAND_AUTO_COMPLETE_INTERACTION_TYPE:
+		data = &tempest.CommandInteractionData{}
+	case tempest.MESSAGE_COMPONENT_INTERACTION_TYPE:
+		data = &tempest.ComponentInteractionData{}
+	case tempest.MODAL_SUBMIT_INTERACTION_TYPE:
+		data = &tempest.ModalInteractionData{}
+	default:
+		return nil
+	}
+
+	if err := tempest.UnmarshalStrict(interaction.Data, data); err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+	return nil
+}
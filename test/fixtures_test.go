@@ -0,0 +1,9 @@
+package test
+
+import "testing"
+
+func TestFixtures(t *testing.T) {
+	if err := CheckFixtures(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+const (
+	APPLICATION_ID tempest.Snowflake = 100000000000000001 // Application ID used by NewClient & interaction builders.
+	USER_ID        tempest.Snowflake = 100000000000000002 // ID of user invoking interactions created by builders.
+	GUILD_ID       tempest.Snowflake = 100000000000000003 // Guild where interactions created by builders come from.
+	CHANNEL_ID     tempest.Snowflake = 100000000000000004 // Channel where interactions created by builders come from.
+)
+
+var lastInteractionID atomic.Uint64
+
+// Signer signs interaction requests just like Discord does, with its own ed25519 key pair.
+type Signer struct {
+	PublicKey  string // Hex encoded public key, pass it as tempest.ClientOptions.PublicKey.
+	privateKey ed25519.PrivateKey
+}
+
+// Creates signer with freshly generated key pair.
+func NewSigner() Signer {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic("test: failed to generate key pair: " + err.Error())
+	}
+
+	return Signer{PublicKey: hex.EncodeToString(publicKey), privateKey: privateKey}
+}
+
+// Creates client ready for tests: it uses generated token & signer's public key, and sends all requests to returned MockRest.
+// Token & PublicKey options are overwritten.
+func NewClient(opt tempest.ClientOptions) (tempest.Client, *MockRest, Signer) {
+	signer := NewSigner()
+	opt.Token = Token(APPLICATION_ID)
+	opt.PublicKey = signer.PublicKey
+
+	client := tempest.NewClient(opt)
+	mock := NewMockRest()
+	mock.Attach(client.Rest)
+	return client, mock, signer
+}
+
+// Returns syntactically valid (but fake) bot token for given application ID.
+func Token(applicationID tempest.Snowflake) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(applicationID.String())) + ".fake.token"
+}
+
+// Returns POST request with given interaction (or any other JSON payload), signed with signer's private key.
+func (signer Signer) Request(interaction any) *http.Request {
+	body, err := json.Marshal(interaction)
+	if err != nil {
+		panic("test: failed to encode interaction: " + err.Error())
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := ed25519.Sign(signer.privateKey, append([]byte(timestamp), body...))
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", tempest.CONTENT_TYPE_JSON)
+	req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(signature))
+	req.Header.Set("X-Signature-Timestamp", timestamp)
+	return req
+}
+
+// Sends signed interaction to handler (like tempest.Client or Client.EventHandler wrapped with http.HandlerFunc) and returns recorded response.
+// Handlers run synchronously, so follow-up requests are already recorded by MockRest once it returns.
+func (signer Signer) Serve(handler http.Handler, interaction any) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, signer.Request(interaction))
+	return recorder
+}
+
+// Creates slash command interaction sent by USER_ID in GUILD_ID. Use "name@subcommand" to invoke subcommand.
+func NewCommandInteraction(name string, options ...tempest.CommandInteractionOption) tempest.Interaction {
+	data := tempest.CommandInteractionData{ID: 1, Name: name, Type: tempest.CHAT_INPUT_COMMAND_TYPE, Options: options}
+	if parent, subcommand, ok := cutSubcommand(name); ok {
+		data.Name = parent
+		data.Options = []tempest.CommandInteractionOption{{Name: subcommand, Type: tempest.SUB_OPTION_TYPE, Options: options}}
+	}
+	return newInteraction(tempest.APPLICATION_COMMAND_INTERACTION_TYPE, data)
+}
+
+// Creates button click interaction with given custom ID.
+func NewButtonInteraction(customID string) tempest.Interaction {
+	return newInteraction(tempest.MESSAGE_COMPONENT_INTERACTION_TYPE, tempest.ComponentInteractionData{
+		CustomID: customID,
+		Type:     tempest.BUTTON_COMPONENT_TYPE,
+	})
+}
+
+// Creates select menu interaction with given custom ID & selected values.
+func NewSelectInteraction(customID string, values ...string) tempest.Interaction {
+	return newInteraction(tempest.MESSAGE_COMPONENT_INTERACTION_TYPE, tempest.ComponentInteractionData{
+		CustomID: customID,
+		Type:     tempest.STRING_SELECT_COMPONENT_TYPE,
+		Values:   values,
+	})
+}
+
+// Creates ping interaction, the one Discord sends to verify interactions endpoint.
+func NewPingInteraction() tempest.Interaction {
+	return newInteraction(tempest.PING_INTERACTION_TYPE, nil)
+}
+
+// Returns string option, use it with NewCommandInteraction.
+func StringOption(name string, value string) tempest.CommandInteractionOption {
+	return tempest.CommandInteractionOption{Name: name, Type: tempest.STRING_OPTION_TYPE, Value: value}
+}
+
+// Returns integer option. Value is passed as float64, just like after decoding Discord's JSON.
+func IntOption(name string, value int64) tempest.CommandInteractionOption {
+	return tempest.CommandInteractionOption{Name: name, Type: tempest.INTEGER_OPTION_TYPE, Value: float64(value)}
+}
+
+func BoolOption(name string, value bool) tempest.CommandInteractionOption {
+	return tempest.CommandInteractionOption{Name: name, Type: tempest.BOOLEAN_OPTION_TYPE, Value: value}
+}
+
+func newInteraction(kind tempest.InteractionType, data any) tempest.Interaction {
+	var raw json.RawMessage
+	if data != nil {
+		var err error
+		if raw, err = json.Marshal(data); err != nil {
+			panic("test: failed to encode interaction data: " + err.Error())
+		}
+	}
+
+	id := tempest.SnowflakeFromTime(time.Now()) + tempest.Snowflake(lastInteractionID.Add(1)%(1<<22))
+	return tempest.Interaction{
+		ID:            id,
+		ApplicationID: APPLICATION_ID,
+		Type:          kind,
+		Data:          raw,
+		GuildID:       GUILD_ID,
+		ChannelID:     CHANNEL_ID,
+		Member: &tempest.Member{
+			User:    &tempest.User{ID: USER_ID, Username: "tester"},
+			RoleIDs: []tempest.Snowflake{},
+		},
+		Token:  "interaction-token-" + id.String(),
+		Locale: tempest.ENGLISH_US_LANGUAGE,
+	}
+}
+
+func cutSubcommand(name string) (string, string, bool) {
+	for i := 0; i < len(name); i++ {
+		if name[i] == '@' {
+			return name[:i], name[i+1:], true
+		}
+	}
+	return name, "", false
+}
@@ -0,0 +1,211 @@
+// Package test provides helpers for unit testing bots built with tempest: MockRest that records outgoing requests and
+// returns canned responses, and Signer that creates signed interaction requests accepted by Client.ServeHTTP.
+//
+//	client, mock, signer := test.NewClient(tempest.ClientOptions{})
+//	client.RegisterCommand(pingCommand)
+//	mock.On(http.MethodPost, "/webhooks/*/*", http.StatusOK, tempest.Message{ID: 1})
+//
+//	res := signer.Serve(&client, test.NewCommandInteraction("ping"))
+//
+// For end to end tests with stateful fake of Discord API, check discordfake package instead.
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	tempest "github.com/amatsagu/tempest"
+)
+
+const apiPathPrefix = "/api/v10"
+
+// Request sent through MockRest.
+type Request struct {
+	Method string
+	Route  string      // Route without API prefix, like "/channels/123/messages".
+	Header http.Header // Includes Authorization header.
+	Body   []byte      // Raw body, for requests with files it's whole multipart body.
+}
+
+// Decodes JSON body of request into v.
+func (req Request) Decode(v any) error {
+	return json.Unmarshal(req.Body, v)
+}
+
+type mockResponse struct {
+	method string
+	route  string
+	status int
+	body   []byte
+	times  int // Number of times response can be used, 0 for no limit.
+}
+
+// MockRest replaces Discord API behind tempest.Rest. Requests without matching canned response get 204 No Content.
+type MockRest struct {
+	mu        sync.Mutex
+	responses []*mockResponse
+	requests  []Request
+}
+
+func NewMockRest() *MockRest {
+	return &MockRest{}
+}
+
+// Makes rest send requests to this mock instead of Discord API.
+func (mock *MockRest) Attach(rest *tempest.Rest) {
+	rest.HTTPClient.Transport = mock
+}
+
+// Registers canned response for requests with given method & route. Route segments can be replaced with "*" wildcard
+// (like "/channels/*/messages"). Body is encoded as JSON unless it's []byte or string. Later registrations take priority.
+func (mock *MockRest) On(method string, route string, status int, body any) {
+	mock.add(method, route, status, body, 0)
+}
+
+// Works like MockRest.On but response is used only once, useful to simulate single failure followed by success.
+func (mock *MockRest) Once(method string, route string, status int, body any) {
+	mock.add(method, route, status, body, 1)
+}
+
+// Registers Discord error response, like mock.OnError(http.MethodPost, "/channels/*/messages", http.StatusForbidden, tempest.MISSING_PERMISSIONS_ERROR_CODE).
+func (mock *MockRest) OnError(method string, route string, status int, code tempest.ErrorCode) {
+	mock.On(method, route, status, tempest.RestError{Code: code, Message: http.StatusText(status)})
+}
+
+// Returns copy of all recorded requests, in order they were sent.
+func (mock *MockRest) Requests() []Request {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return append([]Request(nil), mock.requests...)
+}
+
+// Returns recorded requests matching method & route (with the same wildcard rules as MockRest.On).
+func (mock *MockRest) RequestsTo(method string, route string) []Request {
+	var res []Request
+	for _, req := range mock.Requests() {
+		if req.Method == method && matchRoute(route, req.Route) {
+			res = append(res, req)
+		}
+	}
+	return res
+}
+
+// Returns last recorded request, if any.
+func (mock *MockRest) LastRequest() (Request, bool) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if len(mock.requests) == 0 {
+		return Request{}, false
+	}
+	return mock.requests[len(mock.requests)-1], true
+}
+
+// Removes recorded requests & canned responses.
+func (mock *MockRest) Reset() {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.responses = nil
+	mock.requests = nil
+}
+
+func (mock *MockRest) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := r.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req := Request{
+		Method: r.Method,
+		Route:  strings.TrimPrefix(r.URL.RequestURI(), apiPathPrefix),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+
+	mock.mu.Lock()
+	mock.requests = append(mock.requests, req)
+	response := mock.match(req)
+	mock.mu.Unlock()
+
+	recorder := httptest.NewRecorder()
+	if response == nil {
+		recorder.WriteHeader(http.StatusNoContent)
+	} else {
+		recorder.Header().Set("Content-Type", tempest.CONTENT_TYPE_JSON)
+		recorder.WriteHeader(response.status)
+		recorder.Write(response.body)
+	}
+
+	res := recorder.Result()
+	res.Request = r
+	return res, nil
+}
+
+func (mock *MockRest) add(method string, route string, status int, body any, times int) {
+	var raw []byte
+	switch v := body.(type) {
+	case nil:
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		var err error
+		if raw, err = json.Marshal(v); err != nil {
+			panic("test: failed to encode canned response: " + err.Error())
+		}
+	}
+
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.responses = append(mock.responses, &mockResponse{method: method, route: route, status: status, body: raw, times: times})
+}
+
+// Returns newest matching response. Caller must hold lock.
+func (mock *MockRest) match(req Request) *mockResponse {
+	route, _, _ := strings.Cut(req.Route, "?")
+	for i := len(mock.responses) - 1; i >= 0; i-- {
+		response := mock.responses[i]
+		if response.method != req.Method || !matchRoute(response.route, route) {
+			continue
+		}
+
+		if response.times == 1 {
+			mock.responses = append(mock.responses[:i], mock.responses[i+1:]...)
+		} else if response.times > 1 {
+			response.times--
+		}
+		return response
+	}
+	return nil
+}
+
+// Compares routes segment by segment, "*" matches any single segment. Query string is ignored.
+func matchRoute(pattern string, route string) bool {
+	route, _, _ = strings.Cut(route, "?")
+	patternParts := strings.Split(pattern, "/")
+	routeParts := strings.Split(route, "/")
+	if len(patternParts) != len(routeParts) {
+		return false
+	}
+
+	for i, part := range patternParts {
+		if part != "*" && part != routeParts[i] {
+			return false
+		}
+	}
+	return true
+}
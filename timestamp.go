@@ -0,0 +1,57 @@
+package tempest
+
+import (
+	"bytes"
+	"time"
+)
+
+// ISO8601 timestamp used across Discord API. Zero value stands for missing timestamp - it decodes from null (or empty string),
+// encodes back as null and gets skipped by fields with "omitzero" tag. All time.Time methods (like Before, After or Format) are available.
+type ISOTimestamp struct {
+	time.Time
+}
+
+func NewISOTimestamp(t time.Time) ISOTimestamp {
+	return ISOTimestamp{Time: t}
+}
+
+// Returns duration until timestamp, negative once it passed. Zero timestamp returns 0.
+func (ts ISOTimestamp) Until() time.Duration {
+	if ts.IsZero() {
+		return 0
+	}
+	return time.Until(ts.Time)
+}
+
+// Formats timestamp as message markup displayed in each user's timezone & locale, check Timestamp function.
+func (ts ISOTimestamp) Mention(style TimestampStyle) string {
+	return Timestamp(ts.Time, style)
+}
+
+// Returns time elapsed since timestamp. Zero timestamp returns 0.
+func (ts ISOTimestamp) Since() time.Duration {
+	if ts.IsZero() {
+		return 0
+	}
+	return time.Since(ts.Time)
+}
+
+func (ts ISOTimestamp) MarshalJSON() ([]byte, error) {
+	if ts.IsZero() {
+		return []byte("null"), nil
+	}
+
+	buf := make([]byte, 0, len(time.RFC3339Nano)+2)
+	buf = append(buf, '"')
+	buf = ts.UTC().AppendFormat(buf, time.RFC3339Nano)
+	return append(buf, '"'), nil
+}
+
+func (ts *ISOTimestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*ts = ISOTimestamp{}
+		return nil
+	}
+
+	return ts.Time.UnmarshalJSON(data)
+}
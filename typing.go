@@ -0,0 +1,55 @@
+package tempest
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Typing indicator lasts 10 seconds (or until bot sends message), so it's refreshed a bit earlier.
+const TYPING_REFRESH_INTERVAL = time.Second * 8
+
+// Shows "Bot is typing..." indicator in channel for up to 10 seconds or until bot sends message.
+//
+// https://discord.com/developers/docs/resources/channel#trigger-typing-indicator
+func (client *Client) TriggerTyping(channelID Snowflake) error {
+	return client.TriggerTypingWithContext(context.Background(), channelID)
+}
+
+func (client *Client) TriggerTypingWithContext(ctx context.Context, channelID Snowflake) error {
+	_, err := client.Rest.RequestWithContext(ctx, http.MethodPost, "/channels/"+channelID.String()+"/typing", nil)
+	return err
+}
+
+// Runs fn while keeping typing indicator visible in channel - it's re-triggered every TYPING_REFRESH_INTERVAL until fn returns.
+// Failing to trigger typing doesn't stop fn, errors are only logged. Useful for slow operations (like generating reports) in regular messages flow,
+// interactions should defer their response instead.
+func (client *Client) WithTyping(ctx context.Context, channelID Snowflake, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(TYPING_REFRESH_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			if err := client.TriggerTypingWithContext(ctx, channelID); err != nil && ctx.Err() == nil {
+				client.logger.Debug("failed to trigger typing", "channel_id", channelID, "error", err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	err := fn(ctx)
+	cancel()
+	<-done
+	return err
+}
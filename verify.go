@@ -12,31 +12,37 @@ import (
 	"net/http"
 )
 
-// Verifies incoming request if it's from Discord.
-func verifyRequest(r *http.Request, key ed25519.PublicKey) bool {
-	var msg bytes.Buffer
-
-	signature := r.Header.Get("X-Signature-Ed25519")
-	if signature == "" {
-		return false
+// Returned by ParseAndVerifyInteraction when payload wasn't signed by Discord with given public key.
+var ErrInvalidSignature = errors.New("invalid request signature")
+
+// Verifies signature & decodes interaction from raw request parts, without touching net/http server types.
+// It's the building block for serverless adapters (AWS Lambda, Cloudflare Workers, etc.) - check awslambda package for ready to use one.
+// Returned interaction isn't bound to any client, pass it to Client.DispatchInteraction to handle it.
+func ParseAndVerifyInteraction(headers http.Header, body []byte, publicKey ed25519.PublicKey) (Interaction, error) {
+	if len(body) > MAX_REQUEST_BODY_SIZE {
+		return Interaction{}, errors.New("request body is too large")
 	}
 
-	sig, err := hex.DecodeString(signature)
-	if err != nil {
-		return false
+	if !verifySignature(publicKey, headers.Get("X-Signature-Ed25519"), headers.Get("X-Signature-Timestamp"), body) {
+		return Interaction{}, ErrInvalidSignature
 	}
 
-	if len(sig) != ed25519.SignatureSize || sig[63]&224 != 0 {
-		return false
+	var interaction Interaction
+	if err := unmarshalJSON(body, &interaction); err != nil {
+		return Interaction{}, errors.New("invalid body json payload")
 	}
 
+	return interaction, nil
+}
+
+// Verifies incoming request if it's from Discord.
+func verifyRequest(r *http.Request, key ed25519.PublicKey) bool {
+	signature := r.Header.Get("X-Signature-Ed25519")
 	timestamp := r.Header.Get("X-Signature-Timestamp")
-	if timestamp == "" {
+	if signature == "" || timestamp == "" {
 		return false
 	}
 
-	msg.WriteString(timestamp)
-
 	defer r.Body.Close()
 	var body bytes.Buffer
 
@@ -45,12 +51,34 @@ func verifyRequest(r *http.Request, key ed25519.PublicKey) bool {
 		r.Body = io.NopCloser(&body)
 	}()
 
-	// Copy body into buffers
-	_, err = io.Copy(&msg, io.TeeReader(r.Body, &body))
+	_, err := io.Copy(&body, r.Body)
 	if err != nil {
 		return false
 	}
 
+	return verifySignature(key, signature, timestamp, body.Bytes())
+}
+
+func verifySignature(key ed25519.PublicKey, signature string, timestamp string, body []byte) bool {
+	if signature == "" || timestamp == "" {
+		return false
+	}
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	if len(sig) != ed25519.SignatureSize || sig[63]&224 != 0 {
+		return false
+	}
+
+	msg := jsonBufferPool.Get().(*bytes.Buffer)
+	msg.Reset()
+	defer releaseBuffer(msg)
+
+	msg.WriteString(timestamp)
+	msg.Write(body)
 	return ed25519.Verify(key, msg.Bytes(), sig)
 }
 
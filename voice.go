@@ -0,0 +1,120 @@
+package tempest
+
+import (
+	"errors"
+	"net/http"
+)
+
+// https://discord.com/developers/docs/resources/voice#voice-region-object-voice-region-structure
+type VoiceRegion struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Optimal    bool   `json:"optimal"` // Whether it's the closest region to current user's client.
+	Deprecated bool   `json:"deprecated"`
+	Custom     bool   `json:"custom"` // Whether it's custom region (used for events, etc.).
+}
+
+// https://discord.com/developers/docs/resources/voice#voice-state-object-voice-state-structure
+type VoiceState struct {
+	GuildID                 Snowflake    `json:"guild_id,omitempty"`
+	ChannelID               Snowflake    `json:"channel_id,omitempty"` // Empty if user is not connected.
+	UserID                  Snowflake    `json:"user_id"`
+	Member                  *Member      `json:"member,omitempty"`
+	SessionID               string       `json:"session_id"`
+	Deaf                    bool         `json:"deaf"` // Whether user is deafened by the server.
+	Mute                    bool         `json:"mute"` // Whether user is muted by the server.
+	SelfDeaf                bool         `json:"self_deaf"`
+	SelfMute                bool         `json:"self_mute"`
+	SelfStream              bool         `json:"self_stream,omitempty"` // Whether user is streaming with "Go Live".
+	SelfVideo               bool         `json:"self_video"`
+	Suppress                bool         `json:"suppress"` // Whether user can't speak in stage channel.
+	RequestToSpeakTimestamp ISOTimestamp `json:"request_to_speak_timestamp"`
+}
+
+// All fields are optional - only the ones you set will be modified.
+//
+// https://discord.com/developers/docs/resources/voice#modify-current-user-voice-state-json-params
+type ModifyCurrentUserVoiceStatePayload struct {
+	ChannelID               Snowflake    `json:"channel_id,omitempty"` // Stage channel user is currently in.
+	Suppress                *bool        `json:"suppress,omitempty"`   // Use false to become speaker (requires MUTE_MEMBERS permission), true to move to audience.
+	RequestToSpeakTimestamp ISOTimestamp `json:"request_to_speak_timestamp,omitzero"`
+}
+
+// https://discord.com/developers/docs/resources/voice#list-voice-regions
+func (client *Client) FetchVoiceRegions() ([]VoiceRegion, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/voice/regions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]VoiceRegion, 0)
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return nil, errors.New("failed to parse received data from discord")
+	}
+
+	return res, nil
+}
+
+// Returns voice state of user in guild. Discord responds with UNKNOWN_VOICE_STATE_ERROR_CODE if user is not connected to any voice channel.
+//
+// https://discord.com/developers/docs/resources/voice#get-user-voice-state
+func (client *Client) FetchVoiceState(guildID Snowflake, userID Snowflake) (VoiceState, error) {
+	raw, err := client.Rest.Request(http.MethodGet, "/guilds/"+guildID.String()+"/voice-states/"+userID.String(), nil)
+	if err != nil {
+		return VoiceState{}, err
+	}
+
+	res := VoiceState{}
+	err = unmarshalJSON(raw, &res)
+	if err != nil {
+		return VoiceState{}, errors.New("failed to parse received data from discord")
+	}
+
+	if res.Member != nil {
+		res.Member.GuildID = guildID
+	}
+
+	return res, nil
+}
+
+// Updates bot's own voice state in stage channel, like requesting to speak (set RequestToSpeakTimestamp to current time) or unsuppressing itself.
+//
+// https://discord.com/developers/docs/resources/voice#modify-current-user-voice-state
+func (client *Client) ModifyCurrentUserVoiceState(guildID Snowflake, payload ModifyCurrentUserVoiceStatePayload) error {
+	_, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String()+"/voice-states/@me", payload)
+	return err
+}
+
+// Invites user to speak (suppress = false) or moves them to audience (suppress = true) in stage channel they're currently in.
+// Requires MUTE_MEMBERS permission.
+//
+// https://discord.com/developers/docs/resources/voice#modify-user-voice-state
+func (client *Client) SetStageSuppress(guildID Snowflake, userID Snowflake, channelID Snowflake, suppress bool) error {
+	_, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String()+"/voice-states/"+userID.String(), struct {
+		ChannelID Snowflake `json:"channel_id"`
+		Suppress  bool      `json:"suppress"`
+	}{
+		ChannelID: channelID,
+		Suppress:  suppress,
+	})
+	return err
+}
+
+// Moves member (who has to be already connected) to another voice channel. Use channelID = 0 to disconnect them.
+// Requires MOVE_MEMBERS permission (and CONNECT permission in target channel).
+//
+// https://discord.com/developers/docs/resources/guild#modify-guild-member
+func (client *Client) MoveMember(guildID Snowflake, userID Snowflake, channelID Snowflake) error {
+	var target *Snowflake
+	if channelID != 0 {
+		target = &channelID
+	}
+
+	_, err := client.Rest.Request(http.MethodPatch, "/guilds/"+guildID.String()+"/members/"+userID.String(), struct {
+		ChannelID *Snowflake `json:"channel_id"`
+	}{
+		ChannelID: target,
+	})
+	return err
+}